@@ -0,0 +1,168 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var (
+	addFlag  = flag.NewFlagSet("goimps add flags", 2)
+	addAlias = addFlag.String("as", "", "name of the import (default: the package name, or an alias that doesn't collide)")
+	addWrite = addFlag.Bool("w", false, "write result to (source) file instead of stdout")
+)
+
+// cmdAdd adds the import of a path to the file, and writes the result.
+//
+//	$ goimps add -as stderrors errors main.go
+func cmdAdd(stdin io.Reader, stdout, stderr io.Writer, args []string) int {
+	defer func() {
+		*addAlias = ""
+		*addWrite = false
+	}()
+
+	addFlag.Parse(args)
+	if addFlag.NArg() < 1 || addFlag.NArg() > 2 {
+		fmt.Fprintln(stderr, "usage: goimps add [-as alias] [-w] path [file]")
+		return 2
+	}
+	p := addFlag.Arg(0)
+
+	filename, src, err := readSource(stdin, addFlag.Arg(1))
+	if err != nil {
+		fmt.Fprintln(stderr, err.Error())
+		return 1
+	}
+
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+	if err != nil {
+		fmt.Fprintln(stderr, err.Error())
+		return 1
+	}
+
+	imps := analyzeFile(fset, f)
+	for _, i := range imps {
+		if i.path == p && !i.isIgnored() {
+			// already imported
+			return writeResult(stdout, stderr, filename, src, *addWrite && addFlag.Arg(1) != "")
+		}
+	}
+
+	name, err := chooseImportName(f, filename, imps, p, *addAlias)
+	if err != nil {
+		fmt.Fprintln(stderr, err.Error())
+		return 1
+	}
+	addImportSpec(fset, f, name, p)
+
+	res, err := printFixedFileWithConfig(filename, fset, f, src)
+	if err != nil {
+		fmt.Fprintln(stderr, err.Error())
+		return 1
+	}
+
+	return writeResult(stdout, stderr, filename, res, *addWrite && addFlag.Arg(1) != "")
+}
+
+// chooseImportName returns the name for a new import of p in f ("" to use the package name).
+// If alias is "", an alias is chosen when the package name collides with
+// an import, a package level identifier or a local identifier.
+func chooseImportName(f *ast.File, filename string, imps []imp, p, alias string) (string, error) {
+	taken := map[string]bool{}
+	for _, i := range imps {
+		if !i.isIgnored() {
+			taken[i.name] = true
+		}
+	}
+	for name := range f.Scope.Objects {
+		taken[name] = true
+	}
+	siblings, _ := siblingFiles(filename, f.Name.Name)
+	for name := range siblings {
+		taken[name] = true
+	}
+	isTaken := func(name string) bool {
+		return taken[name] || findNameConflict(f, name) != nil
+	}
+
+	if alias != "" {
+		if alias == "_" || alias == "." {
+			return alias, nil
+		}
+		if !token.IsIdentifier(alias) {
+			return "", fmt.Errorf("%q is not an identifier", alias)
+		}
+		if isTaken(alias) {
+			return "", fmt.Errorf("%s is already used in %s", alias, filename)
+		}
+		return alias, nil
+	}
+
+	name, _, _ := resolvePackageName(p)
+	if !isTaken(name) {
+		return "", nil
+	}
+
+	// crypto/rand -> cryptorand, rand2, rand3, ...
+	elems := strings.Split(p, "/")
+	for i := len(elems) - 2; i >= 0; i-- {
+		parent := identPrefix(elems[i])
+		if parent == "" || parent == name || majorVersionRe.MatchString(elems[i]) {
+			continue
+		}
+		if alias := parent + name; !isTaken(alias) {
+			return alias, nil
+		}
+		break
+	}
+	for n := 2; ; n++ {
+		if alias := name + strconv.Itoa(n); !isTaken(alias) {
+			return alias, nil
+		}
+	}
+}
+
+// identPrefix returns the lowercase letters and digits in the path element s
+// without leading digits: go-redis -> goredis
+func identPrefix(s string) string {
+	prefix := ""
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsLetter(r) || (prefix != "" && unicode.IsDigit(r)) {
+			prefix += string(r)
+		}
+	}
+	return prefix
+}
+
+// readSource reads filename, or stdin if filename is "".
+func readSource(stdin io.Reader, filename string) (string, []byte, error) {
+	if filename == "" {
+		src, err := ioutil.ReadAll(stdin)
+		return "<standard input>", src, err
+	}
+
+	src, err := ioutil.ReadFile(filename)
+	return filename, src, err
+}
+
+// writeResult writes res to the file if write is true, or to stdout.
+func writeResult(stdout, stderr io.Writer, filename string, res []byte, write bool) int {
+	if !write {
+		stdout.Write(res)
+		return 0
+	}
+
+	if err := ioutil.WriteFile(filename, res, 0); err != nil {
+		fmt.Fprintln(stderr, err.Error())
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestCmdAdd(t *testing.T) {
+	in := `package main
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/x/y"
+)
+
+var strings = fmt.Sprint(rand.Int(), y.X)
+`
+	tests := []struct {
+		args     []string
+		expected string
+		code     int
+	}{
+		{
+			args: []string{"os"},
+			expected: `package main
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+
+	"github.com/x/y"
+)
+
+var strings = fmt.Sprint(rand.Int(), y.X)
+`,
+		},
+		{
+			args: []string{"github.com/x/z"},
+			expected: `package main
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/x/y"
+	"github.com/x/z"
+)
+
+var strings = fmt.Sprint(rand.Int(), y.X)
+`,
+		},
+		// the package name collides with an import
+		{
+			args: []string{"crypto/rand"},
+			expected: `package main
+
+import (
+	cryptorand "crypto/rand"
+	"fmt"
+	"math/rand"
+
+	"github.com/x/y"
+)
+
+var strings = fmt.Sprint(rand.Int(), y.X)
+`,
+		},
+		// the package name collides with a package level identifier
+		{
+			args: []string{"strings"},
+			expected: `package main
+
+import (
+	"fmt"
+	"math/rand"
+	strings2 "strings"
+
+	"github.com/x/y"
+)
+
+var strings = fmt.Sprint(rand.Int(), y.X)
+`,
+		},
+		{
+			args: []string{"-as", "stdstrings", "strings"},
+			expected: `package main
+
+import (
+	"fmt"
+	"math/rand"
+	stdstrings "strings"
+
+	"github.com/x/y"
+)
+
+var strings = fmt.Sprint(rand.Int(), y.X)
+`,
+		},
+		// already imported
+		{
+			args:     []string{"fmt"},
+			expected: in,
+		},
+		{
+			args: []string{"-as", "rand", "crypto/rand"},
+			code: 1,
+		},
+	}
+
+	for _, test := range tests {
+		stdout := &bytes.Buffer{}
+		stderr := &bytes.Buffer{}
+		if code := cmdAdd(bytes.NewReader([]byte(in)), stdout, stderr, test.args); code != test.code {
+			t.Errorf("goimps add %v should exit with %d, but got %d: %s", test.args, test.code, code, stderr.String())
+			continue
+		}
+		if got := stdout.String(); got != test.expected {
+			t.Errorf("goimps add %v should add the import\n---expected--- \n`%s`\n--- got --- \n`%s`", test.args, test.expected, got)
+		}
+	}
+}
@@ -0,0 +1,268 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"path"
+)
+
+// normalizeAliases rewrites aliases of imports in f.
+// If cfg.Unalias is true, aliases that are the same as the package name and the last element of the path are dropped.
+// If cfg.Clarify is true, an alias is given to imports whose package name differs
+// from the last element of the path.
+//
+//	fmt "fmt"                      -> "fmt"
+//	"gopkg.in/yaml.v3"             -> yaml "gopkg.in/yaml.v3"
+//	"github.com/go-redis/redis/v9" -> redis "github.com/go-redis/redis/v9"
+//
+// Imports whose package name can't be resolved for sure, or that have an alias convention are left as they are.
+// imps must be the result of analyzeFile(fset, f) for the current f.Imports.
+func normalizeAliases(f *ast.File, imps []imp, cfg *aliasConfig) {
+	for n, i := range imps {
+		if i.isIgnored() || i.path == "C" {
+			continue
+		}
+		if _, ok := cfg.aliasOf(i.path); ok {
+			continue
+		}
+		spec := f.Imports[n]
+
+		if i.alias() != "" {
+			if !cfg.Unalias {
+				continue
+			}
+			name, resolvedBy, _ := resolvePackageName(i.path)
+			if resolvedBy != resolvedByGuess && name == i.name && name == path.Base(i.path) {
+				spec.Name = nil
+			}
+			continue
+		}
+
+		if cfg.Clarify && !i.guessed && i.name != path.Base(i.path) {
+			spec.Name = ast.NewIdent(i.name)
+			spec.Name.NamePos = spec.Path.ValuePos
+		}
+	}
+}
+
+// aliasViolation is an import that is not named as an alias convention requires.
+type aliasViolation struct {
+	n     int // index in imps and f.Imports
+	alias string
+}
+
+// aliasViolations returns imports that don't follow the alias conventions in cfg.
+func aliasViolations(imps []imp, cfg *aliasConfig) []aliasViolation {
+	violations := []aliasViolation{}
+	for n, i := range imps {
+		if i.isIgnored() || i.path == "C" {
+			continue
+		}
+
+		if alias, ok := cfg.aliasOf(i.path); ok && alias != i.name {
+			violations = append(violations, aliasViolation{n: n, alias: alias})
+		}
+	}
+
+	return violations
+}
+
+// applyAliasConventions renames imports that don't follow the alias conventions in cfg,
+// and rewrites selectors that use the old names.
+//
+//	"k8s.io/api/core/v1"  ->  corev1 "k8s.io/api/core/v1"
+//	v1.Pod                ->  corev1.Pod
+//
+// It returns diagnostics for imports that can't be renamed safely,
+// including to names declared in other files of the package of filename.
+// imps must be the result of analyzeFile(fset, f) for the current f.Imports, and they are updated.
+func applyAliasConventions(fset *token.FileSet, f *ast.File, filename string, imps []imp, cfg *aliasConfig) []string {
+	diags := []string{}
+	violations := aliasViolations(imps, cfg)
+	if len(violations) == 0 {
+		return diags
+	}
+
+	siblings, _ := siblingFiles(filename, f.Name.Name)
+	for _, v := range violations {
+		i, spec := imps[v.n], f.Imports[v.n]
+
+		if reason := renameImport(f, imps, v.n, v.alias, siblings); reason != "" {
+			diags = append(diags, fmt.Sprintf("%s: import %q is not renamed to %s: %s", fset.Position(spec.Pos()), i.path, v.alias, reason))
+		}
+	}
+
+	return diags
+}
+
+// renameImport gives alias to the import imps[n] of f, or drops its alias if alias is "",
+// and rewrites selectors that use the old name. imps[n] is updated.
+// siblings are the package level names declared in the other files of the package.
+// It returns the reason if the import can't be renamed safely, or "".
+func renameImport(f *ast.File, imps []imp, n int, alias string, siblings map[string]bool) string {
+	i, spec := imps[n], f.Imports[n]
+
+	name, resolvedBy := alias, resolvedByAlias
+	if alias == "" {
+		name, resolvedBy, _ = resolvePackageName(i.path)
+		if resolvedBy == resolvedByGuess {
+			return fmt.Sprintf("the package name of %q is unknown", i.path)
+		}
+	}
+
+	switch {
+	case !token.IsIdentifier(name):
+		return fmt.Sprintf("%q is not an identifier", name)
+	case i.guessed:
+		return fmt.Sprintf("the package name %s is guessed", i.name)
+	case name != i.name && isNameTaken(f, imps, name, i.path):
+		return fmt.Sprintf("%s is already used in the file", name)
+	case name != i.name && siblings[name]:
+		return fmt.Sprintf("%s is declared in another file of the package", name)
+	}
+
+	if alias == "" {
+		spec.Name = nil
+	} else {
+		spec.Name = ast.NewIdent(alias)
+		spec.Name.NamePos = spec.Path.ValuePos
+	}
+	renameSelectors(f, i.name, name)
+
+	imps[n].name = name
+	imps[n].resolvedBy = resolvedBy
+	imps[n].guessed = false
+	return ""
+}
+
+var (
+	aliasFlag  = flag.NewFlagSet("goimps alias flags", 2)
+	aliasWrite = aliasFlag.Bool("w", false, "write result to (source) file instead of stdout")
+)
+
+// cmdAlias renames the import of a path in the file, and rewrites selectors that use the old name.
+// An empty name drops the alias.
+//
+//	$ goimps alias main.go k8s.io/api/core/v1 corev1
+func cmdAlias(stdin io.Reader, stdout, stderr io.Writer, args []string) int {
+	defer func() {
+		*aliasWrite = false
+	}()
+
+	aliasFlag.Parse(args)
+	if aliasFlag.NArg() != 3 {
+		fmt.Fprintln(stderr, `usage: goimps alias [-w] file path name (file is - for standard input, name is "" to drop the alias)`)
+		return 2
+	}
+	file, p, alias := aliasFlag.Arg(0), aliasFlag.Arg(1), aliasFlag.Arg(2)
+	if file == "-" {
+		file = ""
+	}
+
+	filename, src, err := readSource(stdin, file)
+	if err != nil {
+		fmt.Fprintln(stderr, err.Error())
+		return 1
+	}
+
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+	if err != nil {
+		fmt.Fprintln(stderr, err.Error())
+		return 1
+	}
+
+	imps := analyzeFile(fset, f)
+	n := -1
+	for m, i := range imps {
+		if i.path == p && !i.isIgnored() {
+			n = m
+			break
+		}
+	}
+	if n < 0 {
+		fmt.Fprintf(stderr, "%s: %q is not imported\n", filename, p)
+		return 1
+	}
+
+	name := alias
+	if name == "" {
+		name, _, _ = resolvePackageName(p)
+	}
+	if name != imps[n].name {
+		for _, i := range imps {
+			if i.name == name && !i.isIgnored() {
+				fmt.Fprintf(stderr, "%s: import %q can't be renamed to %s: %s is the name of the import of %q\n", fset.Position(f.Imports[n].Pos()), p, name, name, i.path)
+				return 1
+			}
+		}
+		if id := findNameConflict(f, name); id != nil {
+			fmt.Fprintf(stderr, "%s: import %q can't be renamed to %s: %s is also used at %s\n", fset.Position(f.Imports[n].Pos()), p, name, name, fset.Position(id.Pos()))
+			return 1
+		}
+		siblings, _ := siblingFiles(filename, f.Name.Name)
+		if siblings[name] {
+			fmt.Fprintf(stderr, "%s: import %q can't be renamed to %s: %s is declared in another file of the package\n", fset.Position(f.Imports[n].Pos()), p, name, name)
+			return 1
+		}
+	}
+
+	if reason := renameImport(f, imps, n, alias, nil); reason != "" {
+		fmt.Fprintf(stderr, "%s: import %q can't be renamed to %s: %s\n", fset.Position(f.Imports[n].Pos()), p, name, reason)
+		return 1
+	}
+
+	res, err := printFixedFileWithConfig(filename, fset, f, src)
+	if err != nil {
+		fmt.Fprintln(stderr, err.Error())
+		return 1
+	}
+
+	return writeResult(stdout, stderr, filename, res, *aliasWrite && file != "")
+}
+
+// findNameConflict returns an identifier named name in f
+// that would shadow or be shadowed by an import named name.
+// Selected names (x.name and struct fields) and unresolved x of x.name are not conflicts:
+// x is an import or a package level name in another file.
+func findNameConflict(f *ast.File, name string) *ast.Ident {
+	selected := map[*ast.Ident]bool{}
+	var found *ast.Ident
+	ast.Inspect(f, func(n ast.Node) bool {
+		if found != nil {
+			return false
+		}
+
+		switch n := n.(type) {
+		case *ast.SelectorExpr:
+			selected[n.Sel] = true
+			if x, ok := n.X.(*ast.Ident); ok && x.Obj == nil {
+				// a package, checked by imports
+				selected[x] = true
+			}
+		case *ast.StructType:
+			// fields are always selected
+			for _, field := range n.Fields.List {
+				for _, id := range field.Names {
+					selected[id] = true
+				}
+			}
+		case *ast.ImportSpec:
+			if n.Name != nil && n.Name.Name == name {
+				found = n.Name
+			}
+			return false
+		case *ast.Ident:
+			if n.Name == name && !selected[n] && n != f.Name {
+				found = n
+			}
+		}
+		return true
+	})
+
+	return found
+}
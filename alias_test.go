@@ -0,0 +1,267 @@
+package main
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+)
+
+func TestCmdFmtAlias(t *testing.T) {
+	orig := getModCacheDirs
+	defer func() {
+		getModCacheDirs = orig
+	}()
+	getModCacheDirs = func() []string {
+		return []string{filepath.Join("testdata", "modcache")}
+	}
+
+	in := `package main
+
+import (
+	fmt "fmt"
+	yaml "gopkg.in/yaml.v3"
+	r "os"
+	"github.com/Goimps-test/bar/v2"
+	"example.com/unknown/foo.v1"
+)
+
+var _ = fmt.X + yaml.X + r.X + bar.X + foo.X
+`
+	tests := []struct {
+		args     []string
+		expected string
+	}{
+		{
+			expected: `package main
+
+import (
+	"example.com/unknown/foo.v1"
+	fmt "fmt"
+	"github.com/Goimps-test/bar/v2"
+	yaml "gopkg.in/yaml.v3"
+	r "os"
+)
+
+var _ = fmt.X + yaml.X + r.X + bar.X + foo.X
+`,
+		},
+		{
+			args: []string{"-clarify"},
+			expected: `package main
+
+import (
+	"example.com/unknown/foo.v1"
+	fmt "fmt"
+	bar "github.com/Goimps-test/bar/v2"
+	yaml "gopkg.in/yaml.v3"
+	r "os"
+)
+
+var _ = fmt.X + yaml.X + r.X + bar.X + foo.X
+`,
+		},
+		{
+			args: []string{"-unalias"},
+			expected: `package main
+
+import (
+	"example.com/unknown/foo.v1"
+	"fmt"
+	"github.com/Goimps-test/bar/v2"
+	yaml "gopkg.in/yaml.v3"
+	r "os"
+)
+
+var _ = fmt.X + yaml.X + r.X + bar.X + foo.X
+`,
+		},
+	}
+
+	for _, test := range tests {
+		stdout := &bytes.Buffer{}
+		stderr := &bytes.Buffer{}
+		if cmdFmt(bytes.NewReader([]byte(in)), stdout, stderr, test.args) != 0 {
+			t.Errorf("goimps fmt should not fail: %s", stderr.String())
+		}
+		if got := stdout.String(); got != test.expected {
+			t.Errorf("goimps fmt %v should normalize aliases\n---expected--- \n`%s`\n--- got --- \n`%s`", test.args, test.expected, got)
+		}
+	}
+
+	// by .goimps.json
+	tmp, cleanup := writeTestTree(t, map[string]string{
+		".goimps.json": `{"alias": {"unalias": true}}`,
+		"a.go":         "package a\n\nimport fmt \"fmt\"\n\nvar _ = fmt.X\n",
+	})
+	defer cleanup()
+
+	filename := filepath.Join(tmp, "a.go")
+	expected := "package a\n\nimport \"fmt\"\n\nvar _ = fmt.X\n"
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	if cmdFmt(nil, stdout, stderr, []string{filename}) != 0 {
+		t.Errorf("goimps fmt should not fail: %s", stderr.String())
+	}
+	if got := stdout.String(); got != expected {
+		t.Errorf("goimps fmt should drop aliases by .goimps.json\n---expected--- \n`%s`\n--- got --- \n`%s`", expected, got)
+	}
+}
+
+func TestCmdFmtAliasConventions(t *testing.T) {
+	filename := filepath.Join("testdata", "alias", "alias.go")
+	expected := `package alias
+
+import (
+	"os"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = corev1.Pod{ObjectMeta: metav1.ObjectMeta{}}
+
+func f() {
+	stdos := os.Args
+	_ = stdos
+}
+`
+	expectedErr := filename + `:4:2: import "os" is not renamed to stdos: stdos is already used in the file
+`
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	if cmdFmt(nil, stdout, stderr, []string{filename}) != 0 {
+		t.Errorf("goimps fmt should not fail: %s", stderr.String())
+	}
+	if got := stdout.String(); got != expected {
+		t.Errorf("goimps fmt should rename imports by alias conventions\n---expected--- \n`%s`\n--- got --- \n`%s`", expected, got)
+	}
+	if got := stderr.String(); got != expectedErr {
+		t.Errorf("goimps fmt should report imports that can't be renamed: expected %q, but got %q", expectedErr, got)
+	}
+}
+
+func TestCmdFmtAliasConventionsSibling(t *testing.T) {
+	files := map[string]string{
+		".goimps.json": `{"alias": {"conventions": [{"path": "os", "alias": "stdos"}]}}`,
+		"a.go": `package p
+
+import "os"
+
+var _ = os.Args
+`,
+		"b.go": `package p
+
+var stdos = 1
+`,
+	}
+	tmp, cleanup := writeTestTree(t, files)
+	defer cleanup()
+
+	filename := filepath.Join(tmp, "a.go")
+	expectedErr := filename + `:3:8: import "os" is not renamed to stdos: stdos is declared in another file of the package
+`
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	if cmdFmt(nil, stdout, stderr, []string{filename}) != 0 {
+		t.Errorf("goimps fmt should not fail: %s", stderr.String())
+	}
+	if got := stdout.String(); got != files["a.go"] {
+		t.Errorf("goimps fmt should not rename imports to names in other files\n---expected--- \n`%s`\n--- got --- \n`%s`", files["a.go"], got)
+	}
+	if got := stderr.String(); got != expectedErr {
+		t.Errorf("goimps fmt should report imports that can't be renamed: expected %q, but got %q", expectedErr, got)
+	}
+}
+
+func TestCmdAlias(t *testing.T) {
+	in := `package main
+
+import (
+	"errors"
+	"fmt"
+)
+
+func f(x struct{ stderrors int }) error {
+	y := 1
+	fmt.Println(x.stderrors, y)
+	return errors.New("a")
+}
+`
+	tests := []struct {
+		args     []string
+		expected string
+		err      string
+	}{
+		{
+			args: []string{"-", "errors", "stderrors"},
+			expected: `package main
+
+import (
+	stderrors "errors"
+	"fmt"
+)
+
+func f(x struct{ stderrors int }) error {
+	y := 1
+	fmt.Println(x.stderrors, y)
+	return stderrors.New("a")
+}
+`,
+		},
+		{
+			args: []string{"-", "errors", "y"},
+			err:  `<standard input>:4:2: import "errors" can't be renamed to y: y is also used at <standard input>:9:2` + "\n",
+		},
+		{
+			args: []string{"-", "errors", "fmt"},
+			err:  `<standard input>:4:2: import "errors" can't be renamed to fmt: fmt is the name of the import of "fmt"` + "\n",
+		},
+		{
+			args: []string{"-", "strings", "s"},
+			err:  `<standard input>: "strings" is not imported` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		stdout := &bytes.Buffer{}
+		stderr := &bytes.Buffer{}
+		code := cmdAlias(bytes.NewReader([]byte(in)), stdout, stderr, test.args)
+		if test.err != "" {
+			if code != 1 || stderr.String() != test.err {
+				t.Errorf("goimps alias %v should fail with %q, but got %d, %q", test.args, test.err, code, stderr.String())
+			}
+			continue
+		}
+
+		if code != 0 {
+			t.Errorf("goimps alias %v should not fail: %s", test.args, stderr.String())
+		}
+		if got := stdout.String(); got != test.expected {
+			t.Errorf("goimps alias %v should rename the import\n---expected--- \n`%s`\n--- got --- \n`%s`", test.args, test.expected, got)
+		}
+	}
+
+	// drop the alias
+	in = `package main
+
+import f "fmt"
+
+var _ = f.Sprint
+`
+	expected := `package main
+
+import "fmt"
+
+var _ = fmt.Sprint
+`
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	if cmdAlias(bytes.NewReader([]byte(in)), stdout, stderr, []string{"-", "fmt", ""}) != 0 {
+		t.Errorf("goimps alias should not fail: %s", stderr.String())
+	}
+	if got := stdout.String(); got != expected {
+		t.Errorf("goimps alias should drop the alias\n---expected--- \n`%s`\n--- got --- \n`%s`", expected, got)
+	}
+}
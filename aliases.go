@@ -0,0 +1,225 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strings"
+)
+
+var (
+	aliasesFlag = flag.NewFlagSet("goimps aliases flags", 2)
+	aliasesFix  = aliasesFlag.Bool("fix", false, "rename imports to the most common alias of each path and write result to the files")
+)
+
+// aliasUse is an import of a path named alias ("" if the import has no alias) in a file.
+type aliasUse struct {
+	alias string
+	file  *aliasFile
+	n     int // index in file.imps and file.f.Imports
+}
+
+type aliasFile struct {
+	filename string
+	src      []byte
+	fset     *token.FileSet
+	f        *ast.File
+	imps     []imp
+
+	siblings map[string]bool // package level names in the other files of the package, read on first rename
+}
+
+// cmdAliases reports import paths that are imported with different aliases.
+// It returns 1 if any inconsistency is found and not fixed.
+//
+//	$ goimps aliases ./...
+//	k8s.io/api/core/v1
+//		2 corev1: a.go:5:2 b.go:4:2
+//		1 v1: c.go:6:2
+func cmdAliases(stdout, stderr io.Writer, args []string) int {
+	defer func() {
+		*aliasesFix = false
+	}()
+
+	aliasesFlag.Parse(args)
+	if aliasesFlag.NArg() == 0 {
+		fmt.Fprintln(stderr, "usage: goimps aliases [-fix] paths...")
+		return 2
+	}
+
+	files := []*aliasFile{}
+	for _, p := range aliasesFlag.Args() {
+		filenames, err := goFiles(p)
+		if err != nil {
+			fmt.Fprintln(stderr, err.Error())
+			return 1
+		}
+
+		for _, filename := range filenames {
+			af, err := parseAliasFile(filename)
+			if err != nil {
+				fmt.Fprintln(stderr, err.Error())
+				return 1
+			}
+			files = append(files, af)
+		}
+	}
+
+	uses := collectAliases(files)
+	paths := []string{}
+	for p, us := range uses {
+		if len(aliasCounts(us)) > 1 {
+			paths = append(paths, p)
+		}
+	}
+	sort.Strings(paths)
+
+	if !*aliasesFix {
+		w := bufio.NewWriter(stdout)
+		for _, p := range paths {
+			writeAliasReport(w, p, uses[p])
+		}
+		w.Flush()
+
+		if len(paths) > 0 {
+			return 1
+		}
+		return 0
+	}
+
+	exitCode := 0
+	changed := map[*aliasFile]bool{}
+	for _, p := range paths {
+		counts := aliasCounts(uses[p])
+		if len(counts) > 1 && counts[0].count == counts[1].count {
+			fmt.Fprintf(stderr, "%s: the most common alias is ambiguous: %s, %s\n", p, aliasLabel(counts[0].alias), aliasLabel(counts[1].alias))
+			exitCode = 1
+			continue
+		}
+
+		alias := counts[0].alias
+		for _, u := range uses[p] {
+			if u.alias == alias {
+				continue
+			}
+
+			if u.file.siblings == nil {
+				u.file.siblings, _ = siblingFiles(u.file.filename, u.file.f.Name.Name)
+			}
+			if reason := renameImport(u.file.f, u.file.imps, u.n, alias, u.file.siblings); reason != "" {
+				pos := u.file.fset.Position(u.file.f.Imports[u.n].Pos())
+				fmt.Fprintf(stderr, "%s: import %q is not renamed to %s: %s\n", pos, p, aliasLabel(alias), reason)
+				exitCode = 1
+				continue
+			}
+			changed[u.file] = true
+		}
+	}
+
+	for _, af := range files {
+		if !changed[af] {
+			continue
+		}
+
+		if err := writeAliasFile(af); err != nil {
+			fmt.Fprintln(stderr, err.Error())
+			return 1
+		}
+		fmt.Fprintln(stdout, af.filename)
+	}
+
+	return exitCode
+}
+
+func parseAliasFile(filename string) (*aliasFile, error) {
+	src, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	return &aliasFile{filename: filename, src: src, fset: fset, f: f, imps: analyzeFile(fset, f)}, nil
+}
+
+// writeAliasFile writes the renamed imports and selectors of af to the file.
+func writeAliasFile(af *aliasFile) error {
+	res, err := printFixedFileWithConfig(af.filename, af.fset, af.f, af.src)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(af.filename, res, 0)
+}
+
+// collectAliases returns the imports in files by import path.
+func collectAliases(files []*aliasFile) map[string][]aliasUse {
+	uses := map[string][]aliasUse{}
+	for _, af := range files {
+		for n, i := range af.imps {
+			if i.isIgnored() || i.path == "C" {
+				continue
+			}
+			uses[i.path] = append(uses[i.path], aliasUse{alias: i.alias(), file: af, n: n})
+		}
+	}
+
+	return uses
+}
+
+type aliasCount struct {
+	alias string
+	count int
+}
+
+// aliasCounts counts the aliases in uses, most common first.
+func aliasCounts(uses []aliasUse) []aliasCount {
+	counts := []aliasCount{}
+	index := map[string]int{}
+	for _, u := range uses {
+		if i, ok := index[u.alias]; ok {
+			counts[i].count++
+			continue
+		}
+		index[u.alias] = len(counts)
+		counts = append(counts, aliasCount{alias: u.alias, count: 1})
+	}
+
+	sort.SliceStable(counts, func(i, j int) bool {
+		if counts[i].count != counts[j].count {
+			return counts[i].count > counts[j].count
+		}
+		return counts[i].alias < counts[j].alias
+	})
+	return counts
+}
+
+func writeAliasReport(w io.Writer, p string, uses []aliasUse) {
+	fmt.Fprintln(w, p)
+	for _, c := range aliasCounts(uses) {
+		positions := []string{}
+		for _, u := range uses {
+			if u.alias == c.alias {
+				positions = append(positions, u.file.fset.Position(u.file.f.Imports[u.n].Pos()).String())
+			}
+		}
+		fmt.Fprintf(w, "\t%d %s: %s\n", c.count, aliasLabel(c.alias), strings.Join(positions, " "))
+	}
+}
+
+func aliasLabel(alias string) string {
+	if alias == "" {
+		return "(no alias)"
+	}
+	return alias
+}
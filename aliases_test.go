@@ -0,0 +1,150 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCmdAliases(t *testing.T) {
+	root := filepath.Join("testdata", "aliases")
+	expected := `errors
+	2 (no alias): ` + filepath.Join(root, "a", "a2.go") + `:4:2 ` + filepath.Join(root, "b", "b.go") + `:4:2
+	1 stderrors: ` + filepath.Join(root, "a", "a.go") + `:4:2
+k8s.io/api/core/v1
+	2 corev1: ` + filepath.Join(root, "a", "a.go") + `:6:2 ` + filepath.Join(root, "a", "a2.go") + `:6:2
+	1 core: ` + filepath.Join(root, "b", "b2.go") + `:4:2
+	1 v1: ` + filepath.Join(root, "b", "b.go") + `:6:2
+`
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	if cmdAliases(stdout, stderr, []string{root + "/..."}) != 1 {
+		t.Errorf("goimps aliases should fail for inconsistent aliases: %s", stderr.String())
+	}
+	if got := stdout.String(); got != expected {
+		t.Errorf("goimps aliases should report inconsistent aliases\n---expected--- \n`%s`\n--- got --- \n`%s`", expected, got)
+	}
+}
+
+func TestCmdAliasesFix(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "goimps-aliases-test")
+	if err != nil {
+		panic(err)
+	}
+	defer os.RemoveAll(tmp)
+
+	for _, name := range []string{"a/a.go", "a/a2.go", "b/b.go", "b/b2.go"} {
+		b, err := ioutil.ReadFile(filepath.Join("testdata", "aliases", name))
+		if err != nil {
+			panic(err)
+		}
+		if err := os.MkdirAll(filepath.Join(tmp, filepath.Dir(name)), 0755); err != nil {
+			panic(err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(tmp, name), b, 0644); err != nil {
+			panic(err)
+		}
+	}
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	if cmdAliases(stdout, stderr, []string{"-fix", tmp + "/..."}) != 1 {
+		t.Errorf("goimps aliases -fix should fail for imports that can't be renamed")
+	}
+
+	expectedErr := filepath.Join(tmp, "b", "b2.go") + `:4:2: import "k8s.io/api/core/v1" is not renamed to corev1: corev1 is already used in the file
+`
+	if got := stderr.String(); got != expectedErr {
+		t.Errorf("goimps aliases -fix should report imports that can't be renamed: expected %q, but got %q", expectedErr, got)
+	}
+
+	expected := map[string]string{
+		"a/a.go": `package a
+
+import (
+	"errors"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+var _ = corev1.Pod{}
+
+var _ = errors.New
+`,
+		"b/b.go": `package b
+
+import (
+	"errors"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+var _ = corev1.Pod{}
+
+var _ = errors.New
+`,
+	}
+	for name, e := range expected {
+		b, err := ioutil.ReadFile(filepath.Join(tmp, name))
+		if err != nil {
+			panic(err)
+		}
+		if got := string(b); got != e {
+			t.Errorf("goimps aliases -fix should rename %s\n---expected--- \n`%s`\n--- got --- \n`%s`", name, e, got)
+		}
+	}
+}
+
+func TestCmdAliasesFixSibling(t *testing.T) {
+	files := map[string]string{
+		"x/x.go":  "package x\n\nimport stderrors \"errors\"\n\nvar _ = stderrors.New\n",
+		"y/y.go":  "package y\n\nimport stderrors \"errors\"\n\nvar _ = stderrors.New\n",
+		"z/z.go":  "package z\n\nimport \"errors\"\n\nvar _ = errors.New\n",
+		"z/z2.go": "package z\n\nvar stderrors = 1\n",
+	}
+	tmp, cleanup := writeTestTree(t, files)
+	defer cleanup()
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	if cmdAliases(stdout, stderr, []string{"-fix", tmp + "/..."}) != 1 {
+		t.Errorf("goimps aliases -fix should fail for imports that can't be renamed")
+	}
+
+	expectedErr := filepath.Join(tmp, "z", "z.go") + `:3:8: import "errors" is not renamed to stderrors: stderrors is declared in another file of the package
+`
+	if got := stderr.String(); got != expectedErr {
+		t.Errorf("goimps aliases -fix should report imports that can't be renamed: expected %q, but got %q", expectedErr, got)
+	}
+
+	b, err := ioutil.ReadFile(filepath.Join(tmp, "z", "z.go"))
+	if err != nil {
+		panic(err)
+	}
+	if got := string(b); got != files["z/z.go"] {
+		t.Errorf("goimps aliases -fix should not rename imports to names in other files\n---expected--- \n`%s`\n--- got --- \n`%s`", files["z/z.go"], got)
+	}
+}
+
+func TestGoFiles(t *testing.T) {
+	root := filepath.Join("testdata", "aliases")
+
+	files, err := goFiles(root)
+	if err != nil {
+		panic(err)
+	}
+	if len(files) != 0 {
+		t.Errorf("expected no files in %s, but got %v", root, files)
+	}
+
+	files, err = goFiles(root + "/...")
+	if err != nil {
+		panic(err)
+	}
+	if len(files) != 4 {
+		t.Errorf("expected 4 files in %s/..., but got %v", root, files)
+	}
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"strings"
+)
+
+// isolateCImport moves `import "C"` out of import groups that have other specs.
+//
+// cgo reads the preamble from the doc comment of `import "C"`,
+// so the spec must not be sorted or merged with others.
+//
+//	import (
+//		"fmt"
+//		// #include <stdio.h>
+//		"C"
+//	)
+//	->
+//	// #include <stdio.h>
+//	import "C"
+//
+//	import (
+//		"fmt"
+//	)
+//
+// It returns the rewritten source and true if src is changed.
+func isolateCImport(fset *token.FileSet, f *ast.File, src []byte) ([]byte, bool) {
+	offset := func(p token.Pos) int {
+		return fset.Position(p).Offset
+	}
+
+	for _, decl := range f.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.IMPORT || len(gen.Specs) < 2 {
+			continue
+		}
+
+		for _, spec := range gen.Specs {
+			spec := spec.(*ast.ImportSpec)
+			if unquote(spec.Path.Value) != "C" {
+				continue
+			}
+
+			// lines of the spec and its doc comment
+			start := offset(spec.Pos())
+			if spec.Doc != nil {
+				start = offset(spec.Doc.Pos())
+			}
+			for start > 0 && src[start-1] != '\n' {
+				start--
+			}
+			end := offset(spec.End())
+			if spec.Comment != nil {
+				end = offset(spec.Comment.End())
+			}
+			for end < len(src) && src[end] != '\n' {
+				end++
+			}
+			if end < len(src) {
+				end++
+			}
+
+			cimport := ""
+			if spec.Doc != nil {
+				for _, c := range spec.Doc.List {
+					cimport += c.Text + "\n"
+				}
+			}
+			cimport += "import " + spec.Path.Value
+			if spec.Comment != nil {
+				cimport += " " + spec.Comment.List[0].Text
+			}
+			cimport += "\n\n"
+
+			declStart := offset(gen.Pos())
+			if gen.Doc != nil {
+				declStart = offset(gen.Doc.Pos())
+			}
+
+			res := []byte{}
+			res = append(res, src[:declStart]...)
+			res = append(res, cimport...)
+			res = append(res, src[declStart:start]...)
+			res = append(res, src[end:]...)
+			return res, true
+		}
+	}
+
+	return src, false
+}
+
+// checkCgoPreamble returns diagnostics for `import "C"` whose preamble seems
+// to be separated from it by a blank line. cgo ignores such a preamble.
+func checkCgoPreamble(fset *token.FileSet, f *ast.File) []string {
+	diags := []string{}
+
+	prevEnd := f.Name.End()
+	for _, decl := range f.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.IMPORT || !isCImportDecl(gen) || gen.Doc != nil {
+			prevEnd = decl.End()
+			continue
+		}
+
+		// the last comment group between the previous declaration and `import "C"`
+		var last *ast.CommentGroup
+		for _, cg := range f.Comments {
+			if prevEnd < cg.Pos() && cg.End() < gen.Pos() {
+				last = cg
+			}
+		}
+
+		if last != nil && isCgoPreamble(last) {
+			pos := fset.Position(gen.Pos())
+			diags = append(diags, fmt.Sprintf("%s: cgo preamble at line %d is separated from import \"C\" by a blank line", pos, fset.Position(last.Pos()).Line))
+		}
+
+		prevEnd = decl.End()
+	}
+
+	return diags
+}
+
+// isCgoPreamble reports whether the comment looks like C code or #cgo directives.
+func isCgoPreamble(cg *ast.CommentGroup) bool {
+	for _, l := range strings.Split(cg.Text(), "\n") {
+		l = strings.TrimSpace(l)
+		if strings.HasPrefix(l, "#") || strings.HasSuffix(l, ";") || strings.HasSuffix(l, "{") {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestIsolateCImport(t *testing.T) {
+	tests := []struct {
+		in       string
+		expected string
+	}{
+		{
+			in: `package main
+
+import (
+	"os"
+	// #include <stdio.h>
+	// void hello() { puts("hello"); }
+	"C"
+	"fmt"
+)
+
+func main() {
+	fmt.Println(os.Args)
+	C.hello()
+}
+`,
+			expected: `package main
+
+// #include <stdio.h>
+// void hello() { puts("hello"); }
+import "C"
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() {
+	fmt.Println(os.Args)
+	C.hello()
+}
+`,
+		},
+		// import "C" that is already separated is kept as it is
+		{
+			in: `package main
+
+import (
+	"os"
+)
+
+/*
+#include <stdlib.h>
+*/
+import "C"
+
+func main() {
+	C.free(nil)
+	_ = os.Args
+}
+`,
+			expected: `package main
+
+import (
+	"os"
+)
+
+/*
+#include <stdlib.h>
+*/
+import "C"
+
+func main() {
+	C.free(nil)
+	_ = os.Args
+}
+`,
+		},
+	}
+
+	for _, test := range tests {
+		stdout := &bytes.Buffer{}
+		stderr := &bytes.Buffer{}
+		if cmdFmt(bytes.NewReader([]byte(test.in)), stdout, stderr, []string{}) != 0 {
+			t.Errorf("goimps fmt should not fail: %s", stderr.String())
+			continue
+		}
+
+		got := stdout.String()
+		if got != test.expected {
+			t.Errorf("goimps fmt should keep import \"C\" with its preamble\n---expected--- \n`%s`\n--- got --- \n`%s`", test.expected, got)
+		}
+	}
+}
+
+func TestCheckCgoPreamble(t *testing.T) {
+	in := `package main
+
+// #include <stdio.h>
+
+import "C"
+
+func main() {
+}
+`
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	if cmdFmt(bytes.NewReader([]byte(in)), stdout, stderr, []string{}) != 0 {
+		t.Errorf("goimps fmt should not fail: %s", stderr.String())
+	}
+
+	expected := `<standard input>:5:1: cgo preamble at line 3 is separated from import "C" by a blank line`
+	if got := strings.TrimSpace(stderr.String()); got != expected {
+		t.Errorf("expected diagnostic is `%s`, but got `%s`", expected, got)
+	}
+
+	// no diagnostic for ordinary comments
+	in = `package main
+
+// Command foo does something.
+
+import "C"
+`
+	stderr.Reset()
+	cmdFmt(bytes.NewReader([]byte(in)), stdout, stderr, []string{})
+	if stderr.Len() != 0 {
+		t.Errorf("expected no diagnostic, but got `%s`", stderr.String())
+	}
+}
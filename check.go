@@ -0,0 +1,201 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// cmdCheck reports imports that violate the rules in .goimps.json,
+// standard packages newer than the go directive in go.mod, and imports that can't be resolved.
+// It returns 1 if any problem is found.
+//
+//	$ goimps check main.go
+//	main.go:5:2: import "k8s.io/api/core/v1" should be named corev1, not v1
+//	main.go:6:2: import "github.com/Sirupsen/logrus" is not found; did you mean "github.com/sirupsen/logrus"?
+func cmdCheck(stdin io.Reader, stdout, stderr io.Writer, args []string) int {
+	if len(args) == 0 {
+		src, err := ioutil.ReadAll(stdin)
+		if err != nil {
+			fmt.Fprintln(stderr, err.Error())
+			return 1
+		}
+		return checkResult(stdout, stderr, "<standard input>", src)
+	}
+
+	exitCode := 0
+	for _, p := range args {
+		files, err := goFiles(p)
+		if err != nil {
+			fmt.Fprintln(stderr, err.Error())
+			return 1
+		}
+
+		for _, filename := range files {
+			src, err := ioutil.ReadFile(filename)
+			if err != nil {
+				fmt.Fprintln(stderr, err.Error())
+				return 1
+			}
+			if code := checkResult(stdout, stderr, filename, src); code > exitCode {
+				exitCode = code
+			}
+		}
+	}
+
+	return exitCode
+}
+
+func checkResult(stdout, stderr io.Writer, filename string, src []byte) int {
+	diags, err := checkFile(filename, src)
+	if err != nil {
+		fmt.Fprintln(stderr, err.Error())
+		return 1
+	}
+
+	for _, diag := range diags {
+		fmt.Fprintln(stdout, diag)
+	}
+	if len(diags) > 0 {
+		return 1
+	}
+	return 0
+}
+
+// checkFile returns diagnostics for imports in src.
+func checkFile(filename string, src []byte) ([]string, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, filename, src, parser.Mode(0))
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := loadConfig(configDir(filename))
+	if err != nil {
+		return nil, err
+	}
+	if err := cfg.compile(); err != nil {
+		return nil, err
+	}
+
+	dir := configDir(filename)
+	imps := analyzeFile(fset, f)
+	goVersion := targetGoVersion(dir)
+	diags := checkImports(fset, f, imps, cfg, goVersion)
+	return append(diags, checkResolvable(fset, f, imps, dir, goVersion)...), nil
+}
+
+// checkImports returns diagnostics for imps of f.
+// Unused imports aren't reported for the alias conventions, as goimps fmt drops them.
+// Standard packages and symbols newer than goVersion are reported too.
+func checkImports(fset *token.FileSet, f *ast.File, imps []imp, cfg *config, goVersion string) []string {
+	diags := []string{}
+
+	// goimps fmt drops unused imports before it applies the alias conventions
+	dropped := map[string]bool{}
+	for _, u := range filterUnused(imps) {
+		if !u.guessed {
+			dropped[u.alias()+" "+u.path] = true
+		}
+	}
+	for _, v := range aliasViolations(imps, &cfg.Alias) {
+		if dropped[imps[v.n].alias()+" "+imps[v.n].path] {
+			continue
+		}
+		diags = append(diags, fmt.Sprintf("%s: import %q should be named %s, not %s", fset.Position(f.Imports[v.n].Pos()), imps[v.n].path, v.alias, imps[v.n].name))
+	}
+
+	for n, i := range imps {
+		if !isStdAvailable(i.path, "", goVersion) {
+			diags = append(diags, fmt.Sprintf("%s: import %q requires go %s, but the target is go %s", fset.Position(f.Imports[n].Pos()), i.path, stdVersionOf(i.path, ""), goVersion))
+			continue
+		}
+
+		for _, r := range i.refs {
+			name := strings.TrimPrefix(r.expr, i.name+".")
+			if !isStdAvailable(i.path, name, goVersion) {
+				diags = append(diags, fmt.Sprintf("%s: %s requires go %s, but the target is go %s", r.pos, r.expr, stdVersionOf(i.path, name), goVersion))
+			}
+		}
+	}
+
+	return diags
+}
+
+// checkResolvable returns diagnostics for imps of f that can't be resolved,
+// with suggestions of similar import paths that may be imported in dir with goVersion.
+func checkResolvable(fset *token.FileSet, f *ast.File, imps []imp, dir, goVersion string) []string {
+	diags := []string{}
+	for n, i := range imps {
+		// analyzeFile resolves imports without an alias in the current directory
+		if i.resolvedBy == resolvedByImport || i.resolvedBy == resolvedByModCache || isResolvable(i.path, dir) {
+			continue
+		}
+
+		diag := fmt.Sprintf("%s: import %q is not found", fset.Position(f.Imports[n].Pos()), i.path)
+		if suggestions := suggestImportPaths(i.path, importPathCandidates(dir, goVersion)); len(suggestions) > 0 {
+			quoted := []string{}
+			for _, p := range suggestions {
+				quoted = append(quoted, strconv.Quote(p))
+			}
+			diag += "; did you mean " + strings.Join(quoted, ", ") + "?"
+		}
+		diags = append(diags, diag)
+	}
+
+	return diags
+}
+
+// goFiles returns p if it's a file, or Go files in p if it's a directory.
+// If p ends with "/...", Go files in subdirectories are returned too,
+// except testdata, vendor and directories whose name begins with "." or "_" as the go command does.
+func goFiles(p string) ([]string, error) {
+	recursive := false
+	if p == "..." || strings.HasSuffix(p, "/...") {
+		recursive = true
+		p = strings.TrimSuffix(strings.TrimSuffix(p, "..."), "/")
+		if p == "" {
+			p = "."
+		}
+	}
+
+	fi, err := os.Stat(p)
+	if err != nil {
+		return nil, err
+	}
+	if !fi.IsDir() {
+		return []string{p}, nil
+	}
+
+	files := []string{}
+	err = filepath.Walk(p, func(path string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		name := fi.Name()
+		if fi.IsDir() {
+			if path == p {
+				return nil
+			}
+			if !recursive || name == "testdata" || name == "vendor" || strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if filepath.Ext(name) == ".go" && !strings.HasPrefix(name, ".") {
+			files = append(files, path)
+		}
+		return nil
+	})
+
+	return files, err
+}
@@ -0,0 +1,180 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+func TestCmdCheck(t *testing.T) {
+	defer useTestImportPaths("testgopath")()
+
+	filename := filepath.Join("testdata", "alias", "alias.go")
+	expected := filename + `:4:2: import "os" should be named stdos, not os
+` + filename + `:6:2: import "k8s.io/api/core/v1" should be named corev1, not v1
+` + filename + `:7:2: import "k8s.io/apimachinery/pkg/apis/meta/v1" should be named metav1, not meta
+` + filename + `:6:2: import "k8s.io/api/core/v1" is not found
+` + filename + `:7:2: import "k8s.io/apimachinery/pkg/apis/meta/v1" is not found
+`
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	if cmdCheck(nil, stdout, stderr, []string{filepath.Join("testdata", "alias")}) != 1 {
+		t.Errorf("goimps check should fail for violations: %s", stderr.String())
+	}
+	if got := stdout.String(); got != expected {
+		t.Errorf("goimps check should report violations of alias conventions\n---expected--- \n`%s`\n--- got --- \n`%s`", expected, got)
+	}
+
+	stdout.Reset()
+	in := "package main\n\nimport \"fmt\"\n\nvar _ = fmt.Sprint\n"
+	if cmdCheck(bytes.NewReader([]byte(in)), stdout, stderr, nil) != 0 || stdout.Len() != 0 {
+		t.Errorf("goimps check should not report anything without violations: %s", stdout.String())
+	}
+}
+
+func TestCmdCheckUnused(t *testing.T) {
+	tmp, cleanup := writeTestTree(t, map[string]string{
+		".goimps.json": `{"alias": {"conventions": [{"path": "os", "alias": "stdos"}, {"path": "fmt", "alias": "stdfmt"}]}}`,
+		"a.go": `package a
+
+import (
+	"fmt"
+	"os"
+)
+
+var _ = fmt.Sprint
+`,
+	})
+	defer cleanup()
+
+	filename := filepath.Join(tmp, "a.go")
+	expected := filename + `:4:2: import "fmt" should be named stdfmt, not fmt
+`
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	if cmdCheck(nil, stdout, stderr, []string{filename}) != 1 {
+		t.Errorf("goimps check should fail for violations: %s", stderr.String())
+	}
+	if got := stdout.String(); got != expected {
+		t.Errorf("goimps check should not report unused imports that goimps fmt drops\n---expected--- \n`%s`\n--- got --- \n`%s`", expected, got)
+	}
+}
+
+func TestCmdCheckGoVersion(t *testing.T) {
+	tmp, cleanup := writeTestTree(t, map[string]string{
+		"go.mod": "module example.com/m\n\ngo 1.18\n",
+		"a.go": `package a
+
+import (
+	"slices"
+	"strings"
+)
+
+var _, _, _ = strings.Cut("a=b", "=")
+var _, _ = strings.CutPrefix("ab", "a")
+var _ = slices.Contains([]int{1}, 1)
+`,
+	})
+	defer cleanup()
+
+	filename := filepath.Join(tmp, "a.go")
+	expected := filename + `:4:2: import "slices" requires go 1.21, but the target is go 1.18
+` + filename + `:9:12: strings.CutPrefix requires go 1.20, but the target is go 1.18
+`
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	if cmdCheck(nil, stdout, stderr, []string{filename}) != 1 {
+		t.Errorf("goimps check should fail for standard packages newer than go.mod: %s", stderr.String())
+	}
+	if got := stdout.String(); got != expected {
+		t.Errorf("goimps check should report standard packages and symbols newer than go.mod\n---expected--- \n`%s`\n--- got --- \n`%s`", expected, got)
+	}
+}
+
+func TestCmdCheckResolvable(t *testing.T) {
+	defer useTestImportPaths("testgopath")()
+
+	filename := filepath.Join("testdata", "unresolved", "unresolved.go")
+	expected := filename + `:4:2: import "fmtt" is not found; did you mean "fmt"?
+` + filename + `:6:2: import "github.com/Goimps-test/Bar/v2" is not found; did you mean "github.com/Goimps-test/bar/v2"?
+` + filename + `:7:2: import "gopkg.in/yaml.v2" is not found; did you mean "gopkg.in/yaml.v3"?
+`
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	if cmdCheck(nil, stdout, stderr, []string{filename}) != 1 {
+		t.Errorf("goimps check should fail for unresolvable imports: %s", stderr.String())
+	}
+	if got := stdout.String(); got != expected {
+		t.Errorf("goimps check should report unresolvable imports with suggestions\n---expected--- \n`%s`\n--- got --- \n`%s`", expected, got)
+	}
+}
+
+func TestCmdCheckResolvableInModule(t *testing.T) {
+	defer useTestImportPaths("testgopath")()
+
+	tmp, cleanup := writeTestTree(t, map[string]string{
+		"go.mod":                           "module example.com/m\n",
+		"util/util.go":                     "package util\n\nfunc X() {}\n",
+		"vendor/github.com/foo/bar/bar.go": "package bar\n\nfunc Y() {}\n",
+		"cmd/main.go": `package main
+
+import (
+	"example.com/m/util"
+	"github.com/foo/bar"
+)
+
+func main() {
+	util.X()
+	bar.Y()
+}
+`,
+	})
+	defer cleanup()
+
+	// the current directory is out of the module
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	if cmdCheck(nil, stdout, stderr, []string{filepath.Join(tmp, "cmd", "main.go")}) != 0 || stdout.Len() != 0 {
+		t.Errorf("goimps check should resolve imports of packages in the module and vendor: %s%s", stdout.String(), stderr.String())
+	}
+}
+
+func TestCmdCheckResolvableGoVersion(t *testing.T) {
+	defer useTestImportPaths("testgopath")()
+
+	tmp, cleanup := writeTestTree(t, map[string]string{
+		"a.go": "package a\n\nimport \"slice\"\n\nvar _ = slice.X\n",
+	})
+	defer cleanup()
+
+	filename := filepath.Join(tmp, "a.go")
+
+	tests := []struct {
+		goVersion string
+		expected  string
+	}{
+		// slices is too new to be suggested
+		{goVersion: "1.20", expected: filename + `:3:8: import "slice" is not found` + "\n"},
+		{goVersion: "1.21", expected: filename + `:3:8: import "slice" is not found; did you mean "slices"?` + "\n"},
+	}
+
+	for _, test := range tests {
+		if err := ioutil.WriteFile(filepath.Join(tmp, "go.mod"), []byte("module example.com/m\n\ngo "+test.goVersion+"\n"), 0644); err != nil {
+			panic(err)
+		}
+
+		stdout := &bytes.Buffer{}
+		stderr := &bytes.Buffer{}
+		if cmdCheck(nil, stdout, stderr, []string{filename}) != 1 {
+			t.Errorf("goimps check should fail for unresolvable imports: %s", stderr.String())
+		}
+		if got := stdout.String(); got != test.expected {
+			t.Errorf("goimps check should suggest import paths available in go %s: expected %q, but got %q", test.goVersion, test.expected, got)
+		}
+	}
+}
@@ -0,0 +1,285 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+const configFileName = ".goimps.json"
+
+// config is read from .goimps.json in the directory of the file or its parents.
+//
+//	{
+//		"resolve": {
+//			"rules": ["sibling", "stdlib", "module", "frequency", "shortest"],
+//			"overrides": {"rand": "crypto/rand"},
+//			"ambiguous": "report"
+//		},
+//		"group": {
+//			"regroup": true,
+//			"local": ["example.com/org"],
+//			"custom": ["^k8s\\.io/"]
+//		},
+//		"decl": {
+//			"merge": true,
+//			"collapse": true
+//		},
+//		"alias": {
+//			"unalias": true,
+//			"clarify": true,
+//			"conventions": [
+//				{"path": "k8s.io/apimachinery/pkg/apis/meta/v1", "alias": "metav1"},
+//				{"pattern": "^k8s\\.io/api/(\\w+)/(v\\w+)$", "alias": "$1$2"}
+//			]
+//		},
+//		"modernize": {
+//			"rewrites": [
+//				{"from": "github.com/pkg/errors.Is", "to": "errors.Is", "go": "1.13"}
+//			]
+//		}
+//	}
+type config struct {
+	dir string // directory that has .goimps.json
+
+	Resolve   resolveConfig   `json:"resolve"`
+	Group     groupConfig     `json:"group"`
+	Decl      declConfig      `json:"decl"`
+	Alias     aliasConfig     `json:"alias"`
+	Modernize modernizeConfig `json:"modernize"`
+}
+
+// resolveConfig is the policy for choosing a package when several packages match a name.
+type resolveConfig struct {
+	// Rules are applied in order until one of them prefers a candidate.
+	// sibling:   imported by other files in the same package
+	// stdlib:    standard packages
+	// module:    packages in the same module
+	// frequency: most frequently imported in the module (or the directory that has .goimps.json)
+	// shortest:  fewest path segments
+	Rules []string `json:"rules"`
+
+	// Overrides maps package names to import paths that are always used.
+	Overrides map[string]string `json:"overrides"`
+
+	// Ambiguous is what to do when Rules can't choose between the top-ranked paths:
+	// "guess" (use the first of them in lexical order) or "report" (don't add import).
+	Ambiguous string `json:"ambiguous"`
+}
+
+// groupConfig is the policy for grouping imports in an import declaration.
+//
+// If Regroup is true, imports are grouped in this order and separated by a blank line:
+// standard packages, third-party packages, local packages (Local) and
+// a group for each of Custom patterns.
+// Custom patterns take precedence over others.
+type groupConfig struct {
+	Regroup bool     `json:"regroup"`
+	Local   []string `json:"local"`  // import path prefixes
+	Custom  []string `json:"custom"` // regular expressions of import paths
+
+	custom []*regexp.Regexp
+}
+
+func (g *groupConfig) compile() error {
+	g.custom = nil
+	for _, pattern := range g.Custom {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return err
+		}
+		g.custom = append(g.custom, re)
+	}
+
+	return nil
+}
+
+// groupOf returns the index of the group for the import path p.
+func (g *groupConfig) groupOf(p string) int {
+	for i, re := range g.custom {
+		if re.MatchString(p) {
+			return 3 + i
+		}
+	}
+
+	for _, prefix := range g.Local {
+		if p == prefix || strings.HasPrefix(p, strings.TrimSuffix(prefix, "/")+"/") {
+			return 2
+		}
+	}
+
+	if isStdImportPath(p) {
+		return 0
+	}
+	return 1
+}
+
+// declConfig is the style of import declarations.
+type declConfig struct {
+	Merge    bool `json:"merge"`    // merge import declarations into one (except `import "C"`)
+	Collapse bool `json:"collapse"` // write a single import without parentheses
+}
+
+// aliasConfig is the policy for aliases of imports.
+type aliasConfig struct {
+	// Unalias drops aliases that are the same as the package name and the last element of the path.
+	Unalias bool `json:"unalias"`
+
+	// Clarify gives an alias to imports whose package name differs from the last element of the path.
+	Clarify bool `json:"clarify"`
+
+	// Conventions are required aliases. The first one that matches an import path is applied.
+	Conventions []aliasConvention `json:"conventions"`
+}
+
+// aliasConvention requires Alias for the import of Path, or imports whose path matches Pattern.
+// Alias may refer to capture groups of Pattern ($1, ${name}).
+type aliasConvention struct {
+	Path    string `json:"path"`
+	Pattern string `json:"pattern"`
+	Alias   string `json:"alias"`
+
+	re *regexp.Regexp
+}
+
+func (a *aliasConfig) compile() error {
+	for i := range a.Conventions {
+		c := &a.Conventions[i]
+		if (c.Path == "") == (c.Pattern == "") || c.Alias == "" {
+			return fmt.Errorf("alias convention must have either path or pattern, and alias: %+v", *c)
+		}
+
+		c.re = nil
+		if c.Pattern != "" {
+			re, err := regexp.Compile(c.Pattern)
+			if err != nil {
+				return err
+			}
+			c.re = re
+		}
+	}
+
+	return nil
+}
+
+// aliasOf returns the alias required for the import path p.
+func (a *aliasConfig) aliasOf(p string) (string, bool) {
+	for _, c := range a.Conventions {
+		if c.re == nil {
+			if c.Path == p {
+				return c.Alias, true
+			}
+			continue
+		}
+
+		if m := c.re.FindStringSubmatchIndex(p); m != nil {
+			return string(c.re.ExpandString(nil, c.Alias, p, m)), true
+		}
+	}
+
+	return "", false
+}
+
+// modernizeConfig is the rewrites that goimps modernize applies in addition to the built-in ones.
+type modernizeConfig struct {
+	Rewrites []modernization `json:"rewrites"`
+}
+
+// modernization rewrites references to From (path.Name) to To (path.Name)
+// if the Go version of the module is Go or later.
+type modernization struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	Go   string `json:"go"`
+}
+
+func defaultConfig() *config {
+	return &config{
+		Resolve: resolveConfig{
+			Rules:     []string{"sibling", "stdlib"},
+			Overrides: map[string]string{},
+			Ambiguous: "guess",
+		},
+	}
+}
+
+// loadConfig reads the nearest .goimps.json in dir or its parents.
+// If no config file is found, the default config is returned.
+func loadConfig(dir string) (*config, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		filename := filepath.Join(dir, configFileName)
+		b, err := ioutil.ReadFile(filename)
+		if err == nil {
+			cfg := defaultConfig()
+			if err := json.Unmarshal(b, cfg); err != nil {
+				return nil, &os.PathError{Op: "parse", Path: filename, Err: err}
+			}
+			cfg.dir = dir
+			return cfg, cfg.validate(filename)
+		}
+		if !os.IsNotExist(err) {
+			return nil, err
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return defaultConfig(), nil
+		}
+		dir = parent
+	}
+}
+
+func (cfg *config) validate(filename string) error {
+	for _, rule := range cfg.Resolve.Rules {
+		if !rankRules[rule] {
+			return fmt.Errorf("%s: unknown resolve rule %q", filename, rule)
+		}
+	}
+
+	switch cfg.Resolve.Ambiguous {
+	case "guess", "report":
+	default:
+		return fmt.Errorf("%s: resolve.ambiguous must be \"guess\" or \"report\", but got %q", filename, cfg.Resolve.Ambiguous)
+	}
+
+	for _, m := range cfg.Modernize.Rewrites {
+		_, _, fromOK := splitQualifiedSymbol(m.From)
+		_, _, toOK := splitQualifiedSymbol(m.To)
+		if !fromOK || !toOK {
+			return fmt.Errorf("%s: modernize rewrite must be from and to qualified names like path/to/pkg.Name: %+v", filename, m)
+		}
+		if _, _, ok := parseGoVersion(m.Go); m.Go != "" && !ok {
+			return fmt.Errorf("%s: invalid go version %q in modernize rewrite of %s", filename, m.Go, m.From)
+		}
+	}
+
+	return nil
+}
+
+// configDir returns the directory that loadConfig starts from for filename.
+func configDir(filename string) string {
+	if _, err := os.Stat(filename); err != nil {
+		// standard input
+		return "."
+	}
+
+	return filepath.Dir(filename)
+}
+
+// compile compiles the patterns in cfg.
+func (cfg *config) compile() error {
+	if err := cfg.Group.compile(); err != nil {
+		return err
+	}
+
+	return cfg.Alias.compile()
+}
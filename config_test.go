@@ -0,0 +1,91 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadConfig(t *testing.T) {
+	cfg, err := loadConfig(filepath.Join("testdata", "resolve"))
+	if err != nil {
+		panic(err)
+	}
+	if cfg.Resolve.Ambiguous != "report" || cfg.Resolve.Overrides["rand"] != "crypto/rand" || len(cfg.Resolve.Rules) != 2 {
+		t.Errorf("unexpected resolve config: %+v", cfg.Resolve)
+	}
+
+	// defaults
+	cfg, err = loadConfig(filepath.Join("testdata", "testgopath"))
+	if err != nil {
+		panic(err)
+	}
+	if cfg.Resolve.Ambiguous != "guess" || cfg.Group.Regroup || cfg.Alias.Unalias {
+		t.Errorf("unexpected default config: %+v", cfg)
+	}
+}
+
+func TestGroupOf(t *testing.T) {
+	g := &groupConfig{
+		Local:  []string{"example.com/org"},
+		Custom: []string{`^k8s\.io/`, `^example\.com/org/gen/`},
+	}
+	if err := g.compile(); err != nil {
+		panic(err)
+	}
+
+	tests := []struct {
+		path     string
+		expected int
+	}{
+		{path: "fmt", expected: 0},
+		{path: "net/http", expected: 0},
+		{path: "github.com/x/y", expected: 1},
+		{path: "example.com/org", expected: 2},
+		{path: "example.com/org/a", expected: 2},
+		{path: "example.com/organization", expected: 1},
+		{path: "k8s.io/api/core/v1", expected: 3},
+		{path: "example.com/org/gen/pb", expected: 4},
+	}
+
+	for _, test := range tests {
+		if got := g.groupOf(test.path); got != test.expected {
+			t.Errorf("expected group of %s is %d, but got %d", test.path, test.expected, got)
+		}
+	}
+}
+
+func TestAliasOf(t *testing.T) {
+	a := &aliasConfig{
+		Conventions: []aliasConvention{
+			{Path: "k8s.io/apimachinery/pkg/apis/meta/v1", Alias: "metav1"},
+			{Pattern: `^k8s\.io/api/(\w+)/(v\w+)$`, Alias: "$1$2"},
+			{Pattern: `^k8s\.io/api/(?P<group>\w+)/(?P<version>v\w+)/(\w+)$`, Alias: "${group}${version}${3}"},
+		},
+	}
+	if err := a.compile(); err != nil {
+		panic(err)
+	}
+
+	tests := []struct {
+		path     string
+		expected string
+		ok       bool
+	}{
+		{path: "k8s.io/apimachinery/pkg/apis/meta/v1", expected: "metav1", ok: true},
+		{path: "k8s.io/api/core/v1", expected: "corev1", ok: true},
+		{path: "k8s.io/api/apps/v1beta1", expected: "appsv1beta1", ok: true},
+		{path: "k8s.io/api/apps/v1/x", expected: "appsv1x", ok: true},
+		{path: "k8s.io/apimachinery/pkg/apis/meta/v1beta1", expected: "", ok: false},
+	}
+
+	for _, test := range tests {
+		if got, ok := a.aliasOf(test.path); got != test.expected || ok != test.ok {
+			t.Errorf("expected alias of %s is %q (%t), but got %q (%t)", test.path, test.expected, test.ok, got, ok)
+		}
+	}
+
+	a = &aliasConfig{Conventions: []aliasConvention{{Path: "fmt", Pattern: "^fmt$", Alias: "f"}}}
+	if err := a.compile(); err == nil {
+		t.Errorf("a convention that has both path and pattern should be an error")
+	}
+}
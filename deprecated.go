@@ -0,0 +1,133 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+)
+
+// deprecation is the notice of a deprecated package or module.
+type deprecation struct {
+	module  string // module path if the module is deprecated, or "" if the package is
+	message string
+}
+
+func (d deprecation) String() string {
+	if d.module != "" {
+		return "in the deprecated module " + d.module + ": " + d.message
+	}
+	return "deprecated: " + d.message
+}
+
+// cmdDeprecated reports imports of deprecated packages, and packages in deprecated modules.
+// It returns 1 if any is found.
+//
+//	$ goimps deprecated ./...
+//	a.go:4:2: import "io/ioutil" is deprecated: As of Go 1.16, ...
+func cmdDeprecated(stdout, stderr io.Writer, args []string) int {
+	if len(args) == 0 {
+		fmt.Fprintln(stderr, "usage: goimps deprecated paths...")
+		return 2
+	}
+
+	// deprecations by import path (nil if not deprecated)
+	cache := map[string]*deprecation{}
+
+	w := bufio.NewWriter(stdout)
+	defer w.Flush()
+
+	exitCode := 0
+	for _, p := range args {
+		files, err := goFiles(p)
+		if err != nil {
+			fmt.Fprintln(stderr, err.Error())
+			return 1
+		}
+
+		for _, filename := range files {
+			src, err := ioutil.ReadFile(filename)
+			if err != nil {
+				fmt.Fprintln(stderr, err.Error())
+				return 1
+			}
+
+			fset := token.NewFileSet()
+			f, err := parser.ParseFile(fset, filename, src, parser.ImportsOnly)
+			if err != nil {
+				fmt.Fprintln(stderr, err.Error())
+				return 1
+			}
+
+			for _, spec := range f.Imports {
+				ip := unquote(spec.Path.Value)
+				d, ok := cache[ip]
+				if !ok {
+					if _, _, dir := resolvePackageName(ip); dir != "" {
+						d = findDeprecation(dir)
+					}
+					cache[ip] = d
+				}
+				if d == nil {
+					continue
+				}
+
+				fmt.Fprintf(w, "%s: import %q is %s\n", fset.Position(spec.Pos()), ip, d)
+				exitCode = 1
+			}
+		}
+	}
+
+	return exitCode
+}
+
+// findDeprecation returns the deprecation of the package in dir, or of its module in the module cache.
+// It returns nil if neither is deprecated.
+func findDeprecation(dir string) *deprecation {
+	h, err := readPackageHeader(dir, true)
+	if err != nil {
+		h = packageHeader{}
+	}
+	return headerDeprecation(h, dir)
+}
+
+// headerDeprecation returns the deprecation in h, which readPackageHeader(dir, true) returned,
+// or of the module in the module cache that has dir.
+func headerDeprecation(h packageHeader, dir string) *deprecation {
+	if h.deprecated != "" {
+		return &deprecation{message: h.deprecated}
+	}
+
+	if root := modCacheModuleRoot(dir); root != "" {
+		if m, err := readGoMod(filepath.Join(root, "go.mod")); err == nil && m.deprecated != "" {
+			return &deprecation{module: m.path, message: m.deprecated}
+		}
+	}
+
+	return nil
+}
+
+// modCacheModuleRoot returns the root directory of the module (path@version) that has dir
+// if dir is in the module cache.
+func modCacheModuleRoot(dir string) string {
+	for _, modCache := range getModCacheDirs() {
+		rel, err := filepath.Rel(modCache, dir)
+		if err != nil || rel == "." || strings.HasPrefix(rel, "..") {
+			continue
+		}
+
+		root := modCache
+		for _, elem := range strings.Split(rel, string(filepath.Separator)) {
+			root = filepath.Join(root, elem)
+			if strings.Contains(elem, "@") {
+				return root
+			}
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDeprecationOf(t *testing.T) {
+	tests := []struct {
+		comment  []string
+		expected string
+	}{
+		{
+			comment:  []string{"// Package foo does foo.", "//", "// Deprecated: use bar", "// instead.", "//", "// See bar."},
+			expected: "use bar instead.",
+		},
+		{
+			comment:  []string{"// Deprecated: use example.com/new."},
+			expected: "use example.com/new.",
+		},
+		{
+			comment:  []string{"// Package foo does foo.", "// It's not Deprecated: at all."},
+			expected: "",
+		},
+	}
+
+	for _, test := range tests {
+		if got := deprecationOf(test.comment); got != test.expected {
+			t.Errorf("expected %q for %q, but got %q", test.expected, test.comment, got)
+		}
+	}
+}
+
+func TestCmdDeprecated(t *testing.T) {
+	orig := getModCacheDirs
+	defer func() {
+		getModCacheDirs = orig
+	}()
+	getModCacheDirs = func() []string {
+		return []string{filepath.Join("testdata", "modcache")}
+	}
+
+	filename := filepath.Join("testdata", "deprecated", "deprecated.go")
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	if cmdDeprecated(stdout, stderr, []string{filepath.Join("testdata", "deprecated")}) != 1 {
+		t.Errorf("goimps deprecated should fail for deprecated imports: %s", stderr.String())
+	}
+
+	// the notice of io/ioutil depends on the Go version
+	lines := strings.Split(stdout.String(), "\n")
+	if len(lines) != 3 || !strings.HasPrefix(lines[0], filename+`:5:2: import "io/ioutil" is deprecated: As of Go 1.16, `) {
+		t.Errorf("goimps deprecated should report the deprecated package io/ioutil, but got `%s`", stdout.String())
+		return
+	}
+	if got, expected := lines[1], filename+`:7:2: import "github.com/Goimps-test/old" is in the deprecated module github.com/Goimps-test/old: use github.com/Goimps-test/bar/v2 instead.`; got != expected {
+		t.Errorf("goimps deprecated should report the deprecated module\n---expected--- \n`%s`\n--- got --- \n`%s`", expected, got)
+	}
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"go/ast"
+	"go/token"
+	"strings"
+)
+
+// Compiler directives that need the import of a package in the same file.
+//
+//	//go:embed    -> import _ "embed"
+//	//go:linkname -> import _ "unsafe"
+var directiveImports = []struct {
+	path       string
+	directives []string
+}{
+	{path: "embed", directives: []string{"//go:embed"}},
+	{path: "unsafe", directives: []string{"//go:linkname", "//go:cgo_export_static", "//go:cgo_export_dynamic", "//go:cgo_import_dynamic"}},
+}
+
+// fixDirectiveImports adds blank imports required by directives in f,
+// and drops blank imports that are no longer required by any directive.
+// f must be parsed with comments.
+func fixDirectiveImports(fset *token.FileSet, f *ast.File) {
+	for _, di := range directiveImports {
+		used := false
+		for _, d := range di.directives {
+			used = used || hasDirective(f, d)
+		}
+
+		var blank *ast.ImportSpec
+		imported := false
+		for _, spec := range importSpecs(f) {
+			if unquote(spec.Path.Value) != di.path {
+				continue
+			}
+
+			if spec.Name != nil && spec.Name.Name == "_" {
+				blank = spec
+			} else {
+				imported = true
+			}
+		}
+
+		switch {
+		case used && !imported && blank == nil:
+			addImportSpec(fset, f, "_", di.path)
+		case !used && blank != nil:
+			deleteImportSpecFromFile(f, blank)
+		}
+	}
+}
+
+func hasDirective(f *ast.File, directive string) bool {
+	for _, cg := range f.Comments {
+		for _, c := range cg.List {
+			if c.Text == directive || strings.HasPrefix(c.Text, directive+" ") || strings.HasPrefix(c.Text, directive+"\t") {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestFixDirectiveImports(t *testing.T) {
+	tests := []struct {
+		in       string
+		expected string
+	}{
+		// add
+		{
+			in: `package main
+
+import "fmt"
+
+//go:embed hello.txt
+var s string
+
+func main() { fmt.Println(s) }
+`,
+			expected: `package main
+
+import (
+	_ "embed"
+	"fmt"
+)
+
+//go:embed hello.txt
+var s string
+
+func main() { fmt.Println(s) }
+`,
+		},
+		{
+			in: `package main
+
+//go:linkname nanotime runtime.nanotime
+func nanotime() int64
+`,
+			expected: `package main
+
+import _ "unsafe"
+
+//go:linkname nanotime runtime.nanotime
+func nanotime() int64
+`,
+		},
+		{
+			in: `package runtime
+
+import _ "unsafe" // for go:cgo_export_static
+
+//go:cgo_export_static main.callback
+`,
+			expected: `package runtime
+
+import _ "unsafe" // for go:cgo_export_static
+
+//go:cgo_export_static main.callback
+`,
+		},
+		// unused alias is replaced by blank import
+		{
+			in: `package main
+
+import (
+	e "embed"
+)
+
+//go:embed hello.txt
+var s string
+`,
+			expected: `package main
+
+import _ "embed"
+
+//go:embed hello.txt
+var s string
+`,
+		},
+		// keep
+		{
+			in: `package main
+
+import "embed"
+
+//go:embed static
+var static embed.FS
+`,
+			expected: `package main
+
+import "embed"
+
+//go:embed static
+var static embed.FS
+`,
+		},
+		// drop
+		{
+			in: `package main
+
+import (
+	_ "embed"
+	"fmt"
+	_ "unsafe"
+)
+
+func main() { fmt.Println() }
+`,
+			expected: `package main
+
+import (
+	"fmt"
+)
+
+func main() { fmt.Println() }
+`,
+		},
+	}
+
+	for _, test := range tests {
+		stdout := &bytes.Buffer{}
+		stderr := &bytes.Buffer{}
+		if cmdFmt(bytes.NewReader([]byte(test.in)), stdout, stderr, []string{}) != 0 {
+			t.Errorf("goimps fmt should not fail: %s", stderr.String())
+			continue
+		}
+
+		got := stdout.String()
+		if got != test.expected {
+			t.Errorf("goimps fmt should fix imports required by directives\n---expected--- \n`%s`\n--- got --- \n`%s`", test.expected, got)
+		}
+	}
+}
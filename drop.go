@@ -0,0 +1,78 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"io"
+)
+
+var (
+	dropFlag  = flag.NewFlagSet("goimps drop flags", 2)
+	dropWrite = dropFlag.Bool("w", false, "write result to (source) file instead of stdout")
+)
+
+// cmdDrop drops the imports of paths from the file even if they are used,
+// and reports selectors that referred to them to stderr.
+//
+//	$ goimps drop main.go github.com/pkg/errors
+//	main.go:12:9: errors.Wrap refers to the dropped import "github.com/pkg/errors"
+func cmdDrop(stdin io.Reader, stdout, stderr io.Writer, args []string) int {
+	defer func() {
+		*dropWrite = false
+	}()
+
+	dropFlag.Parse(args)
+	if dropFlag.NArg() < 2 {
+		fmt.Fprintln(stderr, "usage: goimps drop [-w] file paths... (file is - for standard input)")
+		return 2
+	}
+
+	file := dropFlag.Arg(0)
+	if file == "-" {
+		file = ""
+	}
+	filename, src, err := readSource(stdin, file)
+	if err != nil {
+		fmt.Fprintln(stderr, err.Error())
+		return 1
+	}
+
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+	if err != nil {
+		fmt.Fprintln(stderr, err.Error())
+		return 1
+	}
+
+	imps := analyzeFile(fset, f)
+	specs := append(f.Imports[:0:0], f.Imports...)
+	for _, p := range dropFlag.Args()[1:] {
+		found := false
+		for n, i := range imps {
+			if i.path != p {
+				continue
+			}
+			found = true
+
+			deleteImportSpecFromFile(f, specs[n])
+			for _, r := range i.refs {
+				fmt.Fprintf(stderr, "%s: %s refers to the dropped import %q\n", r.pos, r.expr, p)
+			}
+		}
+
+		if !found {
+			fmt.Fprintf(stderr, "%s: %q is not imported\n", filename, p)
+			return 1
+		}
+	}
+
+	res, err := printFixedFileWithConfig(filename, fset, f, src)
+	if err != nil {
+		fmt.Fprintln(stderr, err.Error())
+		return 1
+	}
+
+	return writeResult(stdout, stderr, filename, res, *dropWrite && file != "")
+}
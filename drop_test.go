@@ -0,0 +1,53 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestCmdDrop(t *testing.T) {
+	in := `package main
+
+import (
+	"fmt"
+	pkgerrors "github.com/pkg/errors" // comment
+	"os"
+	_ "net/http/pprof"
+)
+
+func main() {
+	fmt.Println(pkgerrors.Wrap(nil, ""), os.Args)
+}
+`
+	expected := `package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() {
+	fmt.Println(pkgerrors.Wrap(nil, ""), os.Args)
+}
+`
+	expectedErr := `<standard input>:11:14: pkgerrors.Wrap refers to the dropped import "github.com/pkg/errors"
+`
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	if cmdDrop(bytes.NewReader([]byte(in)), stdout, stderr, []string{"-", "github.com/pkg/errors", "net/http/pprof"}) != 0 {
+		t.Errorf("goimps drop should not fail: %s", stderr.String())
+	}
+	if got := stdout.String(); got != expected {
+		t.Errorf("goimps drop should drop the imports\n---expected--- \n`%s`\n--- got --- \n`%s`", expected, got)
+	}
+	if got := stderr.String(); got != expectedErr {
+		t.Errorf("goimps drop should report selectors of the dropped imports: expected %q, but got %q", expectedErr, got)
+	}
+
+	stdout.Reset()
+	stderr.Reset()
+	if cmdDrop(bytes.NewReader([]byte(in)), stdout, stderr, []string{"-", "strings"}) != 1 {
+		t.Errorf("goimps drop should fail for a path that is not imported")
+	}
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"go/parser"
 	"go/token"
@@ -9,7 +10,19 @@ import (
 	"os"
 )
 
-func cmdDropable(stdin io.Reader, stdout, stderr io.Writer, filename string) int {
+var (
+	dropableFlag  = flag.NewFlagSet("goimps dropable flags", 2)
+	dropableNames = dropableFlag.Bool("names", false, `show "name path" to tell imports of the same path apart`)
+)
+
+func cmdDropable(stdin io.Reader, stdout, stderr io.Writer, args []string) int {
+	defer func() {
+		*dropableNames = false
+	}()
+
+	dropableFlag.Parse(args)
+	filename := dropableFlag.Arg(0)
+
 	var in io.Reader
 
 	if filename == "" {
@@ -39,6 +52,14 @@ func cmdDropable(stdin io.Reader, stdout, stderr io.Writer, filename string) int
 		return 1
 	}
 
+	if *dropableNames {
+		// names need resolving packages
+		for _, imp := range analyzeFile(fset, af) {
+			fmt.Fprintln(stdout, imp.name+" "+imp.path)
+		}
+		return 0
+	}
+
 	for _, imp := range af.Imports {
 		fmt.Fprintln(stdout, unquote(imp.Path.Value))
 	}
@@ -45,7 +45,7 @@ func foo() {
 	}
 
 	w := bytes.Buffer{}
-	if cmdDropable(nil, &w, os.Stderr, f.Name()) != 0 {
+	if cmdDropable(nil, &w, os.Stderr, []string{f.Name()}) != 0 {
 		panic("error in cmdDropable.")
 	}
 
@@ -70,7 +70,7 @@ func foo() {
 
 	// Input from stdout
 	w.Reset()
-	if cmdDropable(bytes.NewReader([]byte(code)), &w, os.Stderr, "") != 0 {
+	if cmdDropable(bytes.NewReader([]byte(code)), &w, os.Stderr, nil) != 0 {
 		panic("error in cmdDropable.")
 	}
 
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"go/ast"
+	"go/token"
+)
+
+// mergeDuplicateImports merges imports of the same path into one,
+// and rewrites selectors that use the names of dropped imports.
+//
+//	import (                    import (
+//		"errors"                    "errors"
+//		stderrors "errors"   ->  )
+//	)
+//	                            var _ = errors.New
+//	var _ = stderrors.New
+//
+// The import without a name is kept if there is one.
+// A duplicate is left as it is if its name is guessed,
+// or the name of the kept import is declared in the file or used by another import.
+// imps must be the result of analyzeFile(fset, f), and the updated imports are returned.
+func mergeDuplicateImports(fset *token.FileSet, f *ast.File, imps []imp) []imp {
+	specs := append([]*ast.ImportSpec{}, f.Imports...)
+
+	dropped := map[int]bool{}
+	for n, i := range imps {
+		if !i.duplicate || dropped[n] {
+			continue
+		}
+
+		// imports of the path, and the one that is kept
+		dups := []int{}
+		keep := -1
+		for m, j := range imps {
+			if j.path != i.path || j.isIgnored() || dropped[m] {
+				continue
+			}
+			dups = append(dups, m)
+			if !j.guessed && (keep < 0 || (imps[keep].alias() != "" && j.alias() == "")) {
+				keep = m
+			}
+		}
+		if keep < 0 {
+			// all of them are `import "path"` whose name is unknown
+			keep = dups[0]
+		}
+
+		for _, m := range dups {
+			if m == keep {
+				continue
+			}
+
+			name := imps[keep].name
+			if imps[m].name != name && (imps[m].guessed || isNameTaken(f, imps, name, i.path)) {
+				continue
+			}
+
+			renameSelectors(f, imps[m].name, name)
+			deleteImportSpecFromFile(f, specs[m])
+			imps[keep].refs = append(imps[keep].refs, imps[m].refs...)
+			dropped[m] = true
+		}
+	}
+
+	merged := []imp{}
+	for n, i := range imps {
+		if dropped[n] {
+			continue
+		}
+		if i.duplicate {
+			i.duplicate = false
+			for _, j := range merged {
+				i.duplicate = i.duplicate || (j.path == i.path && !j.isIgnored() && !i.isIgnored())
+			}
+		}
+		merged = append(merged, i)
+	}
+
+	return merged
+}
+
+// isNameTaken reports whether name is used in f by an import of a path other than p,
+// or by an identifier that would shadow or be shadowed by an import named name.
+func isNameTaken(f *ast.File, imps []imp, name, p string) bool {
+	for _, i := range imps {
+		if i.name == name && i.path != p {
+			return true
+		}
+	}
+
+	return findNameConflict(f, name) != nil
+}
+
+// renameSelectors rewrites selectors old.X that refer to a package to name.X.
+// Renamed identifiers are written back to the source by printImportDecls.
+func renameSelectors(f *ast.File, old, name string) {
+	ast.Inspect(f, func(n ast.Node) bool {
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		if xid, ok := sel.X.(*ast.Ident); ok && xid.Obj == nil && xid.Name == old {
+			xid.Name = name
+		}
+		return true
+	})
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestCmdFmtDuplicate(t *testing.T) {
+	tests := []struct {
+		args     []string
+		in       string
+		expected string
+	}{
+		{
+			in: `package main
+
+import (
+	"errors"
+	stderrors "errors"
+	"fmt"
+)
+
+var _ = stderrors.New("a")
+var _ = errors.Is
+var _ = fmt.Sprint
+`,
+			expected: `package main
+
+import (
+	"errors"
+	"fmt"
+)
+
+var _ = errors.New("a")
+var _ = errors.Is
+var _ = fmt.Sprint
+`,
+		},
+		{
+			// the name of the kept import is shadowed
+			in: `package main
+
+import (
+	e1 "errors"
+	e2 "errors"
+)
+
+var _ = e1.Is
+
+func f() {
+	e1 := e2.New("a")
+	_ = e1
+}
+`,
+			expected: `package main
+
+import (
+	e1 "errors"
+	e2 "errors"
+)
+
+var _ = e1.Is
+
+func f() {
+	e1 := e2.New("a")
+	_ = e1
+}
+`,
+		},
+		{
+			// the unused alias is dropped, not the used import of the same path
+			args: []string{"-dup=false"},
+			in: `package main
+
+import (
+	"errors"
+	stderrors "errors"
+)
+
+var _ = errors.New
+`,
+			expected: `package main
+
+import (
+	"errors"
+)
+
+var _ = errors.New
+`,
+		},
+	}
+
+	for _, test := range tests {
+		stdout := &bytes.Buffer{}
+		stderr := &bytes.Buffer{}
+		if cmdFmt(bytes.NewReader([]byte(test.in)), stdout, stderr, test.args) != 0 {
+			t.Errorf("goimps fmt should not fail: %s", stderr.String())
+		}
+		if got := stdout.String(); got != test.expected {
+			t.Errorf("goimps fmt %v should merge duplicate imports\n---expected--- \n`%s`\n--- got --- \n`%s`", test.args, test.expected, got)
+		}
+	}
+}
+
+func TestCmdUnusedDuplicate(t *testing.T) {
+	in := `package main
+
+import (
+	"errors"
+	stderrors "errors"
+)
+
+var _ = errors.New
+`
+	// the output is a path per line for editors
+	expected := "errors\n"
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	if cmdUnused(bytes.NewReader([]byte(in)), stdout, stderr, nil) != 0 {
+		t.Errorf("goimps unused should not fail: %s", stderr.String())
+	}
+	if got := stdout.String(); got != expected {
+		t.Errorf("goimps unused should show the path of an unused duplicate import: expected %q, but got %q", expected, got)
+	}
+
+	// -names tells which import of the path is unused
+	expected = "stderrors errors\n"
+	stdout.Reset()
+	if cmdUnused(bytes.NewReader([]byte(in)), stdout, stderr, []string{"-names"}) != 0 {
+		t.Errorf("goimps unused -names should not fail: %s", stderr.String())
+	}
+	if got := stdout.String(); got != expected {
+		t.Errorf("goimps unused -names should show the name of an unused duplicate import: expected %q, but got %q", expected, got)
+	}
+
+	expected = "errors errors\nstderrors errors\n"
+	stdout.Reset()
+	if cmdDropable(bytes.NewReader([]byte(in)), stdout, stderr, []string{"-names"}) != 0 {
+		t.Errorf("goimps dropable -names should not fail: %s", stderr.String())
+	}
+	if got := stdout.String(); got != expected {
+		t.Errorf("goimps dropable -names should show names of duplicate imports: expected %q, but got %q", expected, got)
+	}
+}
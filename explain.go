@@ -0,0 +1,110 @@
+package main
+
+import (
+	"fmt"
+	"go/build"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+)
+
+func cmdExplain(stdin io.Reader, stdout, stderr io.Writer, filename string) int {
+	var in io.Reader
+
+	if filename == "" {
+		filename = "<standard input>"
+		in = stdin
+	} else {
+		f, err := os.Open(filename)
+		if err != nil {
+			fmt.Fprintln(stderr, err.Error())
+			return 1
+		}
+		defer f.Close()
+
+		in = f
+	}
+
+	src, err := ioutil.ReadAll(in)
+	if err != nil {
+		fmt.Fprintln(stderr, err.Error())
+		return 1
+	}
+
+	imps, err := analyzeImports(filename, src)
+	if err != nil {
+		fmt.Fprintln(stderr, err.Error())
+		return 1
+	}
+
+	explainImports(stdout, filename, imps)
+	return 0
+}
+
+// explainImports writes how goimps decides whether each import in src is unused.
+//
+//	$ goimps explain main.go
+//	main.go: GOOS=darwin GOARCH=amd64 GOROOT=/usr/local/go GOPATH=/Users/toqoz cgo=true tags=
+//	"fmt"
+//		name:       fmt
+//		resolved:   build.Import (/usr/local/go/src/fmt)
+//		confidence: certain
+//		references: main.go:6:2 fmt.Println
+//		result:     used
+func explainImports(w io.Writer, filename string, imps []imp) {
+	ctx := build.Default
+	fmt.Fprintf(w, "%s: GOOS=%s GOARCH=%s GOROOT=%s GOPATH=%s cgo=%t tags=%s\n",
+		filename, ctx.GOOS, ctx.GOARCH, ctx.GOROOT, ctx.GOPATH, ctx.CgoEnabled, strings.Join(ctx.BuildTags, ","))
+
+	for _, i := range imps {
+		fmt.Fprintf(w, "%q\n", i.path)
+
+		if i.path == "C" {
+			fmt.Fprintf(w, "\tresult:     kept (cgo)\n")
+			continue
+		}
+
+		fmt.Fprintf(w, "\tname:       %s\n", i.name)
+		switch i.resolvedBy {
+		case resolvedByImport, resolvedByModCache:
+			fmt.Fprintf(w, "\tresolved:   %s (%s)\n", i.resolvedBy, i.dir)
+		case resolvedByGuess:
+			fmt.Fprintf(w, "\tresolved:   %s (the package was not found; the name is inferred from the import path)\n", i.resolvedBy)
+		default:
+			fmt.Fprintf(w, "\tresolved:   %s\n", i.resolvedBy)
+		}
+		if i.guessed {
+			fmt.Fprintf(w, "\tconfidence: guessed\n")
+		} else {
+			fmt.Fprintf(w, "\tconfidence: certain\n")
+		}
+		if i.duplicate {
+			fmt.Fprintf(w, "\tduplicate:  %q is imported more than once\n", i.path)
+		}
+
+		if i.isIgnored() {
+			fmt.Fprintf(w, "\tresult:     kept (%s import)\n", i.name)
+			continue
+		}
+
+		if len(i.refs) == 0 {
+			fmt.Fprintf(w, "\treferences: none (no selector %s.X was found)\n", i.name)
+			if i.guessed {
+				fmt.Fprintf(w, "\tresult:     unused (fmt keeps it because the name is guessed)\n")
+			} else {
+				fmt.Fprintf(w, "\tresult:     unused\n")
+			}
+			continue
+		}
+
+		for n, r := range i.refs {
+			label := ""
+			if n == 0 {
+				label = "references:"
+			}
+			fmt.Fprintf(w, "\t%-11s %s %s\n", label, r.pos, r.expr)
+		}
+		fmt.Fprintf(w, "\tresult:     used\n")
+	}
+}
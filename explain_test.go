@@ -0,0 +1,44 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestCmdExplain(t *testing.T) {
+	code := `package a
+
+import (
+	f "fmt"
+	"os"
+	"github.com/goimps-test/notfound/v2"
+	_ "embed"
+)
+
+func foo() {
+	f.Println(os.Args)
+}`
+
+	var w bytes.Buffer
+	if cmdExplain(bytes.NewReader([]byte(code)), &w, os.Stderr, "") != 0 {
+		panic("error in cmdExplain.")
+	}
+
+	got := w.String()
+	expected := []string{
+		"\"fmt\"\n\tname:       f\n\tresolved:   alias\n\tconfidence: certain\n\treferences: <standard input>:11:2 f.Println\n\tresult:     used\n",
+		"\"os\"\n\tname:       os\n\tresolved:   build.Import (",
+		"\treferences: <standard input>:11:12 os.Args\n\tresult:     used\n",
+		"\"github.com/goimps-test/notfound/v2\"\n\tname:       notfound\n\tresolved:   guess",
+		"\tconfidence: guessed\n\treferences: none (no selector notfound.X was found)\n\tresult:     unused (fmt keeps it because the name is guessed)\n",
+		"\"embed\"\n\tname:       _\n\tresolved:   alias\n\tconfidence: certain\n\tresult:     kept (_ import)\n",
+	}
+
+	for _, e := range expected {
+		if !strings.Contains(got, e) {
+			t.Errorf("expected explanation contains `%s`, but got `%s`", e, got)
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"go/ast"
 	"go/parser"
 	"go/printer"
+	"go/scanner"
 	"go/token"
 	"io"
 	"io/ioutil"
@@ -14,6 +15,8 @@ import (
 	"os/exec"
 	"path/filepath"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 )
 
 var (
@@ -26,6 +29,18 @@ var (
 	useTab    = fmtFlag.Bool("tabs", true, "indent with tabs")
 	tabWidth  = fmtFlag.Int("tabwidth", 8, "tab width")
 	autodrop  = fmtFlag.Bool("D", true, "Automatically drop unused imports")
+	dedup     = fmtFlag.Bool("dup", true, "merge imports of the same path into one and rewrite selectors that use the dropped names")
+	unalias   = fmtFlag.Bool("unalias", false, "drop aliases that are the same as the package name and the last element of the path (default: alias.unalias in .goimps.json)")
+	clarify   = fmtFlag.Bool("clarify", false, "give an alias to imports whose package name differs from the last element of the path (default: alias.clarify in .goimps.json)")
+	autoadd   = fmtFlag.Bool("A", false, "Automatically add missing imports (goimports mode)")
+	ambiguous = fmtFlag.String("ambiguous", "", `with -A, "guess" or "report" when the resolve rules can't choose between packages matching a name (default: resolve.ambiguous in .goimps.json)`)
+	regroup   = fmtFlag.Bool("regroup", false, "regroup imports into standard, third-party, local and custom groups (default: group.regroup in .goimps.json)")
+	local     = fmtFlag.String("local", "", "comma-separated import path prefixes of local packages (implies -regroup)")
+	merge     = fmtFlag.Bool("merge", false, "merge import declarations into one (default: decl.merge in .goimps.json)")
+	collapse  = fmtFlag.Bool("collapse", false, "collapse a parenthesized import declaration of a single import into one line (default: decl.collapse in .goimps.json)")
+	directive = fmtFlag.Bool("directive", true, "add or drop blank imports required by //go:embed and //go:linkname")
+	tolerant  = fmtFlag.Bool("tolerant", false, "fix imports of files that have syntax errors after imports (only import declarations are rewritten)")
+	verbose   = fmtFlag.Bool("v", false, "explain to stderr why each import is kept or dropped")
 )
 
 func cmdFmt(stdin io.Reader, stdout, stderr io.Writer, args []string) int {
@@ -37,12 +52,32 @@ func cmdFmt(stdin io.Reader, stdout, stderr io.Writer, args []string) int {
 		*comments = true
 		*useTab = true
 		*tabWidth = 8
+		*verbose = false
+		*tolerant = false
+		*directive = true
+		*autoadd = false
+		*dedup = true
+		*unalias = false
+		*clarify = false
+		*ambiguous = ""
+		*regroup = false
+		*local = ""
+		*merge = false
+		*collapse = false
 	}()
 
 	fmtFlag.Parse(args)
 
+	if *ambiguous != "" && *ambiguous != "guess" && *ambiguous != "report" {
+		fmt.Fprintf(stderr, "-ambiguous must be \"guess\" or \"report\", but got %q\n", *ambiguous)
+		return 2
+	}
+
+	// packages for -A are listed once for all files
+	x := &importIndex{}
+
 	if fmtFlag.NArg() == 0 {
-		err := doFmtFile("", stdin, stdout)
+		err := doFmtFile("", stdin, stdout, stderr, x)
 		if err != nil {
 			fmt.Fprintln(stderr, err.Error())
 			return 1
@@ -59,13 +94,13 @@ func cmdFmt(stdin io.Reader, stdout, stderr io.Writer, args []string) int {
 		}
 
 		if fi.IsDir() {
-			err := doFmtDir(p, stdout)
+			err := doFmtDir(p, stdout, stderr, x)
 			if err != nil {
 				fmt.Fprintln(stderr, err.Error())
 				return 1
 			}
 		} else {
-			err := doFmtFile(p, nil, stdout)
+			err := doFmtFile(p, nil, stdout, stderr, x)
 			if err != nil {
 				fmt.Fprintln(stdout, err.Error())
 				return 1
@@ -76,7 +111,7 @@ func cmdFmt(stdin io.Reader, stdout, stderr io.Writer, args []string) int {
 	return 0
 }
 
-func doFmtDir(root string, stdout io.Writer) error {
+func doFmtDir(root string, stdout, stderr io.Writer, x *importIndex) error {
 	return filepath.Walk(root, func(path string, fi os.FileInfo, err error) error {
 		if fi.IsDir() {
 			if root == path {
@@ -87,14 +122,14 @@ func doFmtDir(root string, stdout io.Writer) error {
 		}
 
 		if filepath.Ext(fi.Name()) == ".go" && !strings.HasPrefix(fi.Name(), ".") {
-			return doFmtFile(path, nil, stdout)
+			return doFmtFile(path, nil, stdout, stderr, x)
 		}
 
 		return nil
 	})
 }
 
-func doFmtFile(filename string, stdin io.Reader, stdout io.Writer) error {
+func doFmtFile(filename string, stdin io.Reader, stdout, stderr io.Writer, x *importIndex) error {
 	var in io.Reader
 	if filename == "" && stdin != nil {
 		filename = "<standard input>"
@@ -119,54 +154,64 @@ func doFmtFile(filename string, stdin io.Reader, stdout io.Writer) error {
 	if *comments {
 		parserMode |= parser.ParseComments
 	}
-	if *allErrors {
+	if *allErrors || *tolerant {
 		parserMode |= parser.AllErrors
 	}
 	f, err := parser.ParseFile(fset, filename, src, parserMode)
-	if err != nil {
+	if err != nil && (!*tolerant || f == nil || !isImportDeclParsed(fset, f, err)) {
 		return err
 	}
 
-	if *autodrop {
-		// Drop unused imports
-		unused, err := getUnused(filename, src)
-		if err != nil {
+	// `import "C"` must stay with its preamble
+	fixed := src
+	if s, ok := isolateCImport(fset, f, src); ok {
+		fixed = s
+		fset = token.NewFileSet()
+		f, err = parser.ParseFile(fset, filename, fixed, parserMode)
+		if err != nil && (!*tolerant || f == nil || !isImportDeclParsed(fset, f, err)) {
 			return err
 		}
+	}
+	partial := err != nil
 
-		ast.SortImports(fset, f)
-		for i, decl := range f.Decls {
-			gen, ok := decl.(*ast.GenDecl)
-			if !ok || gen.Tok != token.IMPORT {
-				continue
-			}
-
-			for _, u := range unused {
-				deleteImportSpec(fset, gen, u.path)
-			}
+	for _, diag := range checkCgoPreamble(fset, f) {
+		fmt.Fprintln(stderr, diag)
+	}
 
-			if len(gen.Specs) == 0 {
-				f.Decls = append(f.Decls[:i], f.Decls[i+1:]...)
-			}
-		}
+	cfg, err := loadFmtConfig(filename)
+	if err != nil {
+		return err
 	}
 
-	// Print file
-	printerMode := printer.UseSpaces
-	if *useTab {
-		printerMode |= printer.TabIndent
+	// the parser may drop code that uses imports if the file has syntax errors
+	var partialIdents map[string]bool
+	if partial {
+		partialIdents = identsAfterImports(fset, f, fixed)
 	}
-	cfg := &printer.Config{
-		Mode:     printerMode,
-		Tabwidth: *tabWidth,
+
+	err = fixImports(fset, f, filename, cfg, partialIdents, x, stderr)
+	if err != nil {
+		return err
 	}
-	var buf bytes.Buffer
-	err = cfg.Fprint(&buf, fset, f)
+
+	res, err := printImportDecls(fset, f, fixed, cfg)
 	if err != nil {
 		return err
 	}
+	// If the file is being edited and has syntax errors after import declarations,
+	// leave the rest as it is.
+	if !partial {
+		fset = token.NewFileSet()
+		f, err = parser.ParseFile(fset, filename, res, parserMode)
+		if err != nil {
+			return err
+		}
 
-	res := buf.Bytes()
+		res, err = printFile(fset, f)
+		if err != nil {
+			return err
+		}
+	}
 
 	if !bytes.Equal(src, res) {
 		// always output to stdout
@@ -211,36 +256,360 @@ func doFmtFile(filename string, stdin io.Reader, stdout io.Writer) error {
 	return nil
 }
 
-func deleteImportSpec(fset *token.FileSet, gen *ast.GenDecl, path string) {
+// loadFmtConfig loads the config for filename and overrides it by flags.
+func loadFmtConfig(filename string) (*config, error) {
+	cfg, err := loadConfig(configDir(filename))
+	if err != nil {
+		return nil, err
+	}
+
+	if *ambiguous != "" {
+		cfg.Resolve.Ambiguous = *ambiguous
+	}
+	if *regroup {
+		cfg.Group.Regroup = true
+	}
+	if *merge {
+		cfg.Decl.Merge = true
+	}
+	if *collapse {
+		cfg.Decl.Collapse = true
+	}
+	if *unalias {
+		cfg.Alias.Unalias = true
+	}
+	if *clarify {
+		cfg.Alias.Clarify = true
+	}
+	if *local != "" {
+		cfg.Group.Regroup = true
+		cfg.Group.Local = strings.Split(*local, ",")
+	}
+
+	return cfg, cfg.compile()
+}
+
+// fixImports drops unused imports from f and adds missing imports and imports required by directives.
+// If partialIdents isn't nil, f is a partial AST of a file with syntax errors,
+// and imports whose name is in partialIdents are kept.
+// Missing imports are found in x.
+func fixImports(fset *token.FileSet, f *ast.File, filename string, cfg *config, partialIdents map[string]bool, x *importIndex, stderr io.Writer) error {
+	imps := analyzeFile(fset, f)
+
+	if *verbose {
+		explainImports(stderr, filename, imps)
+	}
+
+	if *dedup {
+		imps = mergeDuplicateImports(fset, f, imps)
+	}
+
+	if *autodrop {
+		// Drop unused imports
+		unused := filterUnused(imps)
+
+		decls := []ast.Decl{}
+		for _, decl := range f.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.IMPORT {
+				decls = append(decls, decl)
+				continue
+			}
+
+			for _, u := range unused {
+				if u.guessed {
+					// the package name may be wrong, so we can't know whether it's really unused
+					continue
+				}
+				if partialIdents[u.name] {
+					// it may be used in code that the parser dropped
+					continue
+				}
+				deleteImportSpec(f, gen, u.alias(), u.path)
+			}
+
+			if len(gen.Specs) == 0 {
+				deleteDeclComments(f, gen)
+				continue
+			}
+			decls = append(decls, decl)
+		}
+		f.Decls = decls
+	}
+
+	if cfg.Alias.Unalias || cfg.Alias.Clarify {
+		normalizeAliases(f, imps, &cfg.Alias)
+	}
+
+	for _, diag := range applyAliasConventions(fset, f, filename, imps, &cfg.Alias) {
+		fmt.Fprintln(stderr, diag)
+	}
+
+	if *autoadd {
+		for _, diag := range addMissingImports(fset, f, filename, imps, cfg, x) {
+			fmt.Fprintln(stderr, diag)
+		}
+	}
+
+	// directives can be found only in comments
+	if *directive && *comments {
+		fixDirectiveImports(fset, f)
+	}
+
+	if cfg.Decl.Merge {
+		mergeImportDecls(fset, f)
+	}
+
+	return nil
+}
+
+// printFixedFile renders f parsed from src with comments, after its imports are rewritten.
+// The result is formatted as gofmt does.
+func printFixedFile(fset *token.FileSet, f *ast.File, src []byte, cfg *config) ([]byte, error) {
+	res, err := printImportDecls(fset, f, src, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	fset = token.NewFileSet()
+	f, err = parser.ParseFile(fset, "", res, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	return printFile(fset, f)
+}
+
+// printFixedFileWithConfig is printFixedFile with the config for filename.
+func printFixedFileWithConfig(filename string, fset *token.FileSet, f *ast.File, src []byte) ([]byte, error) {
+	cfg, err := loadConfig(configDir(filename))
+	if err == nil {
+		err = cfg.compile()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return printFixedFile(fset, f, src, cfg)
+}
+
+func printerConfig() *printer.Config {
+	printerMode := printer.UseSpaces
+	if *useTab {
+		printerMode |= printer.TabIndent
+	}
+	return &printer.Config{
+		Mode:     printerMode,
+		Tabwidth: *tabWidth,
+	}
+}
+
+func printFile(fset *token.FileSet, f *ast.File) ([]byte, error) {
+	var buf bytes.Buffer
+	err := printerConfig().Fprint(&buf, fset, f)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// isImportDeclParsed reports whether all errors in err are placed after import declarations of f.
+func isImportDeclParsed(fset *token.FileSet, f *ast.File, err error) bool {
+	errs, ok := err.(scanner.ErrorList)
+	if !ok {
+		return false
+	}
+
+	end := importDeclsEnd(f)
+	for _, e := range errs {
+		if e.Pos.Offset < fset.Position(end).Offset {
+			return false
+		}
+	}
+
+	return true
+}
+
+// importDeclsEnd returns the end of the last import declaration of f, or the package clause.
+func importDeclsEnd(f *ast.File) token.Pos {
+	end := f.Name.End()
+	for _, decl := range f.Decls {
+		if gen, ok := decl.(*ast.GenDecl); ok && gen.Tok == token.IMPORT {
+			end = gen.End()
+		}
+	}
+
+	return end
+}
+
+// identsAfterImports returns the names of identifiers in src after the import declarations of f.
+// The parser may drop code with syntax errors that refers to imports, but the scanner doesn't.
+func identsAfterImports(fset *token.FileSet, f *ast.File, src []byte) map[string]bool {
+	offset := fset.Position(importDeclsEnd(f)).Offset
+	tail := src[offset:]
+
+	var s scanner.Scanner
+	s.Init(token.NewFileSet().AddFile("", -1, len(tail)), tail, nil, 0)
+
+	idents := map[string]bool{}
+	for {
+		_, tok, lit := s.Scan()
+		if tok == token.EOF {
+			break
+		}
+		if tok == token.IDENT {
+			idents[lit] = true
+		}
+	}
+
+	return idents
+}
+
+// printImportDecls renders import declarations of f into src.
+// Other parts of src are left byte-for-byte untouched, except identifiers renamed in f.
+func printImportDecls(fset *token.FileSet, f *ast.File, src []byte, cfg *config) ([]byte, error) {
+	// import declarations of f may be deleted, so they are found in the original source
+	orig, err := parser.ParseFile(token.NewFileSet(), "", src, parser.ImportsOnly|parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	origDecls := []*ast.GenDecl{}
+	origOffsets := map[int]bool{}
+	for _, decl := range orig.Decls {
+		if gen, ok := decl.(*ast.GenDecl); ok && gen.Tok == token.IMPORT {
+			origDecls = append(origDecls, gen)
+			origOffsets[int(gen.Pos())-1] = true
+		}
+	}
+
+	decls := map[int]*ast.GenDecl{}
+	added := []*ast.GenDecl{}
+	for _, decl := range f.Decls {
+		if gen, ok := decl.(*ast.GenDecl); ok && gen.Tok == token.IMPORT {
+			if offset := fset.Position(gen.Pos()).Offset; origOffsets[offset] {
+				decls[offset] = gen
+			} else {
+				added = append(added, gen)
+			}
+		}
+	}
+
+	res := []byte{}
+	last := 0
+	for _, gen := range origDecls {
+		start, end := int(gen.Pos())-1, int(gen.End())-1
+		if start < last {
+			// merged into the previous declaration
+			continue
+		}
+
+		newDecl, ok := decls[start]
+		if !ok {
+			// the declaration is dropped: remove its comments and the line too
+			if gen.Doc != nil {
+				start = int(gen.Doc.Pos()) - 1
+			}
+			if !gen.Lparen.IsValid() {
+				if spec := gen.Specs[0].(*ast.ImportSpec); spec.Comment != nil {
+					end = int(spec.Comment.End()) - 1
+				}
+			}
+			res = append(res, src[last:start]...)
+			last = end
+			for last < len(src) && src[last] == '\n' {
+				last++
+			}
+			continue
+		}
+
+		res = append(res, src[last:start]...)
+		res = append(res, formatImportDecl(fset, f, newDecl, src, cfg)...)
+		last = end
+		if newDecl.Rparen.IsValid() {
+			// declarations merged into newDecl are in [start, newDecl.End())
+			if mergedEnd := fset.Position(newDecl.End()).Offset; mergedEnd > last {
+				last = mergedEnd
+			}
+		}
+	}
+
+	// declarations that are added are placed after the last import declaration or the package clause
+	if len(added) > 0 {
+		if len(origDecls) == 0 {
+			last = int(orig.Name.End()) - 1
+			res = append(res, src[:last]...)
+		}
+		for _, gen := range added {
+			res = append(res, "\n\n"...)
+			res = append(res, formatImportDecl(fset, f, gen, src, cfg)...)
+		}
+		if last < len(src) && src[last] != '\n' {
+			res = append(res, "\n\n"...)
+		}
+	}
+	res = append(res, renameIdents(fset, f, src, last)...)
+
+	return res, nil
+}
+
+// renameIdents returns src[from:] with identifiers that are renamed in f rewritten.
+// An identifier is renamed if its name differs from the source text at its position.
+func renameIdents(fset *token.FileSet, f *ast.File, src []byte, from int) []byte {
+	res := []byte{}
+	last := from
+	ast.Inspect(f, func(n ast.Node) bool {
+		if gen, ok := n.(*ast.GenDecl); ok && gen.Tok == token.IMPORT {
+			// rendered by printImportDecls
+			return false
+		}
+		id, ok := n.(*ast.Ident)
+		if !ok || !id.Pos().IsValid() {
+			return true
+		}
+
+		start := fset.Position(id.Pos()).Offset
+		if start < last {
+			return true
+		}
+		end := start
+		for end < len(src) {
+			r, size := utf8.DecodeRune(src[end:])
+			if r != '_' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+				break
+			}
+			end += size
+		}
+		if string(src[start:end]) == id.Name {
+			return true
+		}
+
+		res = append(res, src[last:start]...)
+		res = append(res, id.Name...)
+		last = end
+		return true
+	})
+
+	return append(res, src[last:]...)
+}
+
+// deleteImportSpec deletes the spec of name and path from gen.
+// name is "" for a spec without a name.
+// Comments attached to the spec are deleted from f too.
+func deleteImportSpec(f *ast.File, gen *ast.GenDecl, name, path string) {
 	for j, spec := range gen.Specs {
 		impspec := spec.(*ast.ImportSpec)
 
 		if strings.Trim(impspec.Path.Value, "`"+`"`) != path {
 			continue
 		}
-
-		// Drop
-		gen.Specs = append(gen.Specs[:j], gen.Specs[j+1:]...)
-
-		// Align
-		if j == 0 {
-			return
-		}
-		lastImpspec := gen.Specs[j-1].(*ast.ImportSpec)
-		lastLine := fset.Position(lastImpspec.Path.ValuePos).Line
-		line := fset.Position(impspec.Path.ValuePos).Line
-		if line == lastLine+1 { // there is no blank line between last and current
-			// import (
-			//     "fmt" // EndPos=2
-			//     "os"  // EndPos=3
-			// )
-			// ->
-			// import (
-			//     "fmt" // EndPos=3
-			// )
-			lastImpspec.EndPos = impspec.End()
+		if (impspec.Name == nil && name != "") || (impspec.Name != nil && impspec.Name.Name != name) {
+			continue
 		}
 
+		gen.Specs = append(gen.Specs[:j], gen.Specs[j+1:]...)
+		deleteSpecComments(f, impspec)
 		return
 	}
 }
@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"regexp"
 	"strings"
 	"testing"
@@ -191,6 +192,21 @@ import (
 func main() {
 	_ = log.Print
 }
+`,
+		},
+		{
+			in: `package main
+
+import (
+	"github.com/goimps-test/notfound/v2"
+	"os"
+)
+`,
+			expected: `package main
+
+import (
+	"github.com/goimps-test/notfound/v2"
+)
 `,
 		},
 		// ------------------------------------
@@ -208,3 +224,406 @@ func main() {
 		}
 	}
 }
+
+func TestCmdFmtTolerant(t *testing.T) {
+	tests := []struct {
+		in       string
+		expected string
+	}{
+		{
+			in: `package main
+
+import (
+	"os"
+	"fmt"
+)
+
+func main() {
+    fmt.Println(
+}
+`,
+			expected: `package main
+
+import (
+	"fmt"
+)
+
+func main() {
+    fmt.Println(
+}
+`,
+		},
+		{
+			in: `package main
+
+import "os"
+
+func main() {
+	x :=
+}
+`,
+			expected: `package main
+
+func main() {
+	x :=
+}
+`,
+		},
+		// imports used in code that the parser drops are kept
+		{
+			in: `package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+func main() {
+	x := strings.
+	fmt.Println(os.Args
+}
+`,
+			expected: `package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+func main() {
+	x := strings.
+	fmt.Println(os.Args
+}
+`,
+		},
+		{
+			in: `package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() {
+	if x := 1; x > {
+		fmt.Println(os.Args)
+	}
+}
+`,
+			expected: `package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() {
+	if x := 1; x > {
+		fmt.Println(os.Args)
+	}
+}
+`,
+		},
+	}
+
+	for _, test := range tests {
+		stdout := &bytes.Buffer{}
+		stderr := &bytes.Buffer{}
+		if cmdFmt(bytes.NewReader([]byte(test.in)), stdout, stderr, []string{"-tolerant"}) != 0 {
+			t.Errorf("goimps fmt -tolerant should not fail: %s", stderr.String())
+			continue
+		}
+
+		got := stdout.String()
+		if got != test.expected {
+			t.Errorf("goimps fmt -tolerant should rewrite only imports\n---expected--- \n`%s`\n--- got --- \n`%s`", test.expected, got)
+		}
+	}
+
+	// errors in import declarations can't be tolerated
+	in := `package main
+
+import (
+	"os
+)
+`
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	if cmdFmt(bytes.NewReader([]byte(in)), stdout, stderr, []string{"-tolerant"}) == 0 {
+		t.Errorf("goimps fmt -tolerant should fail if import declarations have errors")
+	}
+}
+
+func TestCmdFmtComments(t *testing.T) {
+	tests := []struct {
+		in       string
+		expected string
+	}{
+		// comments of dropped imports are dropped
+		{
+			in: `package main
+
+import (
+	// os is needed for X
+	"os" // needed for X
+	"fmt" // print
+	// strings doc
+	"strings" // strings
+	"io" // io
+)
+
+func main() {
+	fmt.Println(io.EOF)
+}
+`,
+			expected: `package main
+
+import (
+	"fmt" // print
+	"io"  // io
+)
+
+func main() {
+	fmt.Println(io.EOF)
+}
+`,
+		},
+		// doc comments are sorted together with their imports
+		{
+			in: `package main
+
+import (
+	// os doc
+	"os"
+	"fmt"
+)
+
+func main() {
+	fmt.Println(os.Args)
+}
+`,
+			expected: `package main
+
+import (
+	"fmt"
+	// os doc
+	"os"
+)
+
+func main() {
+	fmt.Println(os.Args)
+}
+`,
+		},
+		// comments that don't belong to imports are kept
+		{
+			in: `package main
+
+// doc
+import "os" // os
+
+import (
+	"fmt"
+
+	// floating
+
+	"io"
+)
+
+// F does something.
+func F() {
+	fmt.Println()
+}
+`,
+			expected: `package main
+
+import (
+	"fmt"
+
+	// floating
+)
+
+// F does something.
+func F() {
+	fmt.Println()
+}
+`,
+		},
+	}
+
+	for _, test := range tests {
+		stdout := &bytes.Buffer{}
+		stderr := &bytes.Buffer{}
+		if cmdFmt(bytes.NewReader([]byte(test.in)), stdout, stderr, []string{}) != 0 {
+			t.Errorf("goimps fmt should not fail: %s", stderr.String())
+			continue
+		}
+
+		got := stdout.String()
+		if got != test.expected {
+			t.Errorf("goimps fmt should keep comments with imports\n---expected--- \n`%s`\n--- got --- \n`%s`", test.expected, got)
+		}
+	}
+}
+
+func TestCmdFmtRegroup(t *testing.T) {
+	in := `package main
+
+import (
+	"example.com/org/b"
+	"fmt"
+	"github.com/x/y"
+
+	// comment for a
+	"example.com/org/a"
+	"os"
+)
+
+var _ = fmt.X + os.X + b.X + y.X + a.X
+`
+	expected := `package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/x/y"
+
+	// comment for a
+	"example.com/org/a"
+	"example.com/org/b"
+)
+
+var _ = fmt.X + os.X + b.X + y.X + a.X
+`
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	if cmdFmt(bytes.NewReader([]byte(in)), stdout, stderr, []string{"-local", "example.com/org"}) != 0 {
+		t.Errorf("goimps fmt should not fail: %s", stderr.String())
+	}
+	if got := stdout.String(); got != expected {
+		t.Errorf("goimps fmt -local should regroup imports\n---expected--- \n`%s`\n--- got --- \n`%s`", expected, got)
+	}
+
+	// by .goimps.json
+	expected = `package group
+
+import (
+	"fmt"
+
+	"github.com/x/y"
+
+	"example.com/org/b"
+
+	"k8s.io/api/core/v1"
+)
+
+var _ = fmt.X + b.X + y.X + v1.X
+`
+	stdout.Reset()
+	if cmdFmt(nil, stdout, stderr, []string{filepath.Join("testdata", "group", "group.go")}) != 0 {
+		t.Errorf("goimps fmt should not fail: %s", stderr.String())
+	}
+	if got := stdout.String(); got != expected {
+		t.Errorf("goimps fmt should regroup imports by .goimps.json\n---expected--- \n`%s`\n--- got --- \n`%s`", expected, got)
+	}
+}
+
+func TestCmdFmtMerge(t *testing.T) {
+	tests := []struct {
+		args     []string
+		in       string
+		expected string
+	}{
+		{
+			args: []string{"-merge"},
+			in: `package main
+
+import "fmt" // comment for fmt
+
+// comment for os
+import "os"
+
+// #include <stdio.h>
+import "C"
+
+import ()
+
+import (
+	"io"
+)
+import "bytes"
+
+var _ = fmt.X + os.X + io.X + bytes.X
+`,
+			expected: `package main
+
+import (
+	"fmt" // comment for fmt
+
+	// comment for os
+	"os"
+
+	"bytes"
+	"io"
+)
+
+// #include <stdio.h>
+import "C"
+
+var _ = fmt.X + os.X + io.X + bytes.X
+`,
+		},
+		{
+			args: []string{"-collapse"},
+			in: `package main
+
+import (
+	"fmt" // comment for fmt
+)
+
+var _ = fmt.X
+`,
+			expected: `package main
+
+import "fmt" // comment for fmt
+
+var _ = fmt.X
+`,
+		},
+		{
+			args: []string{"-merge", "-collapse"},
+			in: `package main
+
+import ()
+
+import (
+	"fmt"
+)
+
+var _ = fmt.X
+`,
+			expected: `package main
+
+import "fmt"
+
+var _ = fmt.X
+`,
+		},
+	}
+
+	for _, test := range tests {
+		stdout := &bytes.Buffer{}
+		stderr := &bytes.Buffer{}
+		if cmdFmt(bytes.NewReader([]byte(test.in)), stdout, stderr, test.args) != 0 {
+			t.Errorf("goimps fmt should not fail: %s", stderr.String())
+		}
+		if got := stdout.String(); got != test.expected {
+			t.Errorf("goimps fmt %v should normalize import declarations\n---expected--- \n`%s`\n--- got --- \n`%s`", test.args, test.expected, got)
+		}
+	}
+}
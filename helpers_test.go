@@ -1,6 +1,14 @@
 // This file contains helpers for testing/debuging
 package main
 
+import (
+	"go/build"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
 func contains(l []string, a string) bool {
 	for _, s := range l {
 		if s == a {
@@ -41,3 +49,47 @@ ParentLoop:
 
 	return ret
 }
+
+// useTestImportPaths makes packages in GOROOT, testdata/<gopath> and testdata/modcache importable,
+// and returns a function that restores them.
+func useTestImportPaths(gopath string) func() {
+	origSrcDirs, origModCacheDirs := getSrcDirs, getModCacheDirs
+	getSrcDirs = func() []string {
+		return []string{filepath.Join(build.Default.GOROOT, "src"), filepath.Join("testdata", gopath, "src")}
+	}
+	getModCacheDirs = func() []string {
+		return []string{filepath.Join("testdata", "modcache")}
+	}
+	candidatesCache = map[string][]string{}
+
+	return func() {
+		getSrcDirs, getModCacheDirs = origSrcDirs, origModCacheDirs
+		candidatesCache = map[string][]string{}
+	}
+}
+
+// writeTestTree writes files, which are contents by slash separated paths, in a temporary directory.
+// It returns the directory and a function that removes it.
+func writeTestTree(t *testing.T, files map[string]string) (string, func()) {
+	dir, err := ioutil.TempDir("", "goimps-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cleanup := func() {
+		os.RemoveAll(dir)
+	}
+
+	for name, content := range files {
+		filename := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+			cleanup()
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filename, []byte(content), 0644); err != nil {
+			cleanup()
+			t.Fatal(err)
+		}
+	}
+
+	return dir, cleanup
+}
@@ -3,11 +3,14 @@ package main
 import (
 	"bufio"
 	"errors"
+	"flag"
+	"fmt"
 	"go/build"
 	"io"
 	"os"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
 	"sync"
 )
@@ -18,9 +21,74 @@ var (
 
 var getSrcDirs = build.Default.SrcDirs
 
-func cmdImportable(stdout, stderr io.Writer) int {
+var (
+	importableFlag = flag.NewFlagSet("goimps importable flags", 2)
+	importableGo   = importableFlag.String("go", "", "hide standard packages newer than this Go version (default: the go directive in go.mod)")
+
+	importableDeprecated = importableFlag.String("deprecated", "", "annotate or hide deprecated packages and packages in deprecated modules (annotate, hide)")
+)
+
+type importable struct {
+	path string // import path
+	dir  string
+
+	deprecated *deprecation // nil if not deprecated, or if deprecations aren't read
+}
+
+func cmdImportable(stdout, stderr io.Writer, args []string) int {
+	defer func() {
+		*importableGo = ""
+		*importableDeprecated = ""
+	}()
+
+	importableFlag.Parse(args)
+	switch *importableDeprecated {
+	case "", "annotate", "hide":
+	default:
+		fmt.Fprintf(stderr, "-deprecated must be annotate or hide, but got %q\n", *importableDeprecated)
+		return 2
+	}
+	goVersion := *importableGo
+	if goVersion == "" {
+		goVersion = targetGoVersion(".")
+	}
+
+	pkgs, errs := listImportable(*importableDeprecated != "")
+	pkgs = filterByGoVersion(pkgs, goVersion)
+
+	if len(errs) > 0 {
+		for _, err := range errs {
+			// ignore error
+			fmt.Fprintln(stderr, err.Error())
+		}
+		return 1
+	}
+
+	w := bufio.NewWriter(stdout)
+	for _, pkg := range pkgs {
+		if *importableDeprecated == "" {
+			w.WriteString(pkg.path + "\n")
+			continue
+		}
+
+		switch {
+		case pkg.deprecated == nil:
+			w.WriteString(pkg.path + "\n")
+		case *importableDeprecated == "annotate":
+			w.WriteString(pkg.path + "\t" + pkg.deprecated.String() + "\n")
+		}
+	}
+	w.Flush()
+
+	return 0
+}
+
+// listImportable finds importable packages in src dirs.
+// If readDeprecation is true, deprecations of packages are read in the same scan.
+// Packages are sorted by import path.
+func listImportable(readDeprecation bool) ([]importable, []error) {
 	goroutines := &sync.WaitGroup{}
-	pkgFound := make(chan string)
+	pkgFound := make(chan importable)
 	errGot := make(chan error)
 	done := make(chan bool)
 
@@ -43,11 +111,18 @@ func cmdImportable(stdout, stderr io.Writer) int {
 			go func(srcDir string, path string) {
 				defer goroutines.Done()
 
-				ok, err := isImportable(path)
+				h, err := readPackageHeader(path, readDeprecation)
 				if err != nil {
 					errGot <- err
-				} else if ok {
-					pkgFound <- strings.TrimPrefix(path, srcDir+string(filepath.Separator))
+				} else if isImportableName(h.name) {
+					pkg := importable{
+						path: filepath.ToSlash(strings.TrimPrefix(path, srcDir+string(filepath.Separator))),
+						dir:  path,
+					}
+					if readDeprecation {
+						pkg.deprecated = headerDeprecation(h, path)
+					}
+					pkgFound <- pkg
 				}
 			}(srcDir, path)
 
@@ -60,47 +135,47 @@ func cmdImportable(stdout, stderr io.Writer) int {
 		done <- true
 	}()
 
-	isErrGot := false
-	w := bufio.NewWriter(stdout)
+	pkgs := []importable{}
+	errs := []error{}
 	for {
 		select {
-		case pkgname := <-pkgFound:
-			if !isErrGot {
-				_, err := w.WriteString(pkgname + "\n")
-				if err != nil {
-					errGot <- err
-				}
-			}
+		case pkg := <-pkgFound:
+			pkgs = append(pkgs, pkg)
 		case err := <-errGot:
-			isErrGot = true
-			w.Reset(stderr)
-			// ignore error
-			w.WriteString(err.Error() + "\n")
+			errs = append(errs, err)
 		case <-done:
-			w.Flush()
-			if isErrGot {
-				return 1
-			}
-
-			return 0
+			sort.Slice(pkgs, func(i, j int) bool {
+				return pkgs[i].path < pkgs[j].path
+			})
+			return pkgs, errs
 		}
 	}
 }
 
-func isImportable(dir string) (bool, error) {
-	pkgname, err := getPackageNameFromGoFiles(dir)
-	if err != nil {
-		return false, err
-	}
-
-	return pkgname != "main" && pkgname != "", nil
+// isImportableName reports whether a package named pkgname can be imported.
+func isImportableName(pkgname string) bool {
+	return pkgname != "main" && pkgname != ""
 }
 
 // This is little faster than build.Import... (6msec)
 // BenchmarkGetPackageName_by_buildImport       200           7640049 ns/op
 // BenchmarkGetPackageNameFromGoFiles          2000           1312593 ns/op
 func getPackageNameFromGoFiles(dir string) (string, error) {
-	var pkgname string
+	h, err := readPackageHeader(dir, false)
+	return h.name, err
+}
+
+// packageHeader is what is read from the lines up to the package clause of Go files in a directory.
+type packageHeader struct {
+	name       string
+	deprecated string // the "Deprecated:" paragraph of the package doc comment
+}
+
+// readPackageHeader reads the package name from Go files in dir.
+// If deprecation is true, the files are read until the package doc comment
+// that has a "Deprecated:" paragraph is found.
+func readPackageHeader(dir string, deprecation bool) (packageHeader, error) {
+	var h packageHeader
 
 	_break := errors.New("break")
 	err := filepath.Walk(dir, func(path string, fi os.FileInfo, err error) error {
@@ -128,6 +203,8 @@ func getPackageNameFromGoFiles(dir string) (string, error) {
 
 		rdr := bufio.NewReader(f)
 
+		// comment lines just before the current line
+		comment := []string{}
 		for {
 			l, err := rdr.ReadBytes(byte('\n'))
 			if err != nil {
@@ -139,7 +216,9 @@ func getPackageNameFromGoFiles(dir string) (string, error) {
 
 				// got unexpected error...
 				// -> give up
-				pkgname = filepath.Base(dir)
+				if h.name == "" {
+					h.name = filepath.Base(dir)
+				}
 				return _break
 			}
 
@@ -152,17 +231,57 @@ func getPackageNameFromGoFiles(dir string) (string, error) {
 
 			m := packageStmtRe.FindSubmatch(l)
 			if len(m) == 2 {
-				pkgname = string(m[1])
+				if h.name == "" {
+					h.name = string(m[1])
+				}
+				if deprecation {
+					h.deprecated = deprecationOf(comment)
+					if h.deprecated == "" {
+						// the doc comment may be in another file
+						return nil
+					}
+				}
 				return _break
 			}
+
+			if ls := strings.TrimSpace(string(l)); strings.HasPrefix(ls, "//") {
+				comment = append(comment, ls)
+			} else {
+				comment = comment[:0]
+			}
 		}
 	})
 
 	if err != nil && err != _break {
-		return "", err
+		return packageHeader{}, err
+	}
+
+	return h, nil
+}
+
+// deprecationOf returns the paragraph that begins with "Deprecated:" in the comment lines,
+// joined into a line.
+//
+//	// Deprecated: As of Go 1.16, the same functionality is now provided
+//	// by package io or package os.
+//	-> As of Go 1.16, the same functionality is now provided by package io or package os.
+func deprecationOf(comment []string) string {
+	paragraph := []string{}
+	for _, l := range comment {
+		text := strings.TrimSpace(strings.TrimPrefix(l, "//"))
+		switch {
+		case len(paragraph) == 0:
+			if strings.HasPrefix(text, "Deprecated:") {
+				paragraph = append(paragraph, strings.TrimSpace(strings.TrimPrefix(text, "Deprecated:")))
+			}
+		case text == "":
+			return strings.TrimSpace(strings.Join(paragraph, " "))
+		default:
+			paragraph = append(paragraph, text)
+		}
 	}
 
-	return pkgname, nil
+	return strings.TrimSpace(strings.Join(paragraph, " "))
 }
 
 func isBuildable(goos, goarch, buidConstraints string) bool {
@@ -261,7 +261,7 @@ func TestCmdImportable(t *testing.T) {
 	build.Default.GOPATH = "./testdata/testgopath"
 
 	var w bytes.Buffer
-	if cmdImportable(&w, os.Stderr) != 0 {
+	if cmdImportable(&w, os.Stderr, nil) != 0 {
 		panic("error in cmdImportable")
 	}
 
@@ -299,3 +299,39 @@ func isGo14OrLater() bool {
 	vs = strings.Replace(vs, ".", "", -1)
 	return vs[:2] >= "14"
 }
+
+func TestCmdImportableDeprecated(t *testing.T) {
+	tmp, cleanup := writeTestTree(t, map[string]string{
+		"src/example.com/old/old.go": "// Package old is old.\n//\n// Deprecated: use example.com/new.\npackage old\n",
+		"src/example.com/new/new.go": "// Package new is new.\npackage new\n",
+	})
+	defer cleanup()
+
+	orig := getSrcDirs
+	defer func() {
+		getSrcDirs = orig
+	}()
+	getSrcDirs = func() []string {
+		return []string{filepath.Join(tmp, "src")}
+	}
+
+	tests := []struct {
+		args     []string
+		expected string
+	}{
+		{args: []string{"-deprecated", "annotate"}, expected: "example.com/new\nexample.com/old\tdeprecated: use example.com/new.\n"},
+		{args: []string{"-deprecated", "hide"}, expected: "example.com/new\n"},
+		{args: []string{}, expected: "example.com/new\nexample.com/old\n"},
+	}
+
+	for _, test := range tests {
+		stdout := &bytes.Buffer{}
+		stderr := &bytes.Buffer{}
+		if cmdImportable(stdout, stderr, test.args) != 0 {
+			t.Errorf("goimps importable %v should not fail: %s", test.args, stderr.String())
+		}
+		if got := stdout.String(); got != test.expected {
+			t.Errorf("goimps importable %v\n---expected--- \n`%s`\n--- got --- \n`%s`", test.args, test.expected, got)
+		}
+	}
+}
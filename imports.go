@@ -0,0 +1,475 @@
+package main
+
+import (
+	"bytes"
+	"go/ast"
+	"go/token"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var blankLineRe = regexp.MustCompile(`\n[ \t]*\n`)
+
+// addImportSpec adds the import of path named name (it may be "") to f.
+// The spec is added to the first import declaration other than `import "C"`,
+// or a new declaration is created after the package clause.
+func addImportSpec(fset *token.FileSet, f *ast.File, name, path string) *ast.ImportSpec {
+	var gen *ast.GenDecl
+	last := -1
+	for i, decl := range f.Decls {
+		d, ok := decl.(*ast.GenDecl)
+		if !ok || d.Tok != token.IMPORT {
+			continue
+		}
+		last = i
+
+		if gen == nil && !isCImportDecl(d) {
+			gen = d
+		}
+	}
+
+	spec := &ast.ImportSpec{
+		Path: &ast.BasicLit{Kind: token.STRING, Value: strconv.Quote(path)},
+	}
+	if name != "" {
+		spec.Name = ast.NewIdent(name)
+	}
+
+	if gen == nil {
+		gen = &ast.GenDecl{Tok: token.IMPORT}
+		if last >= 0 {
+			gen.TokPos = f.Decls[last].End()
+		} else {
+			gen.TokPos = f.Name.End()
+		}
+		setImportSpecPos(spec, gen.TokPos)
+
+		f.Decls = append(f.Decls[:last+1], append([]ast.Decl{gen}, f.Decls[last+1:]...)...)
+	} else {
+		// place new spec after a related spec, so that it's rendered in the same group
+		related := relatedImportSpec(gen, path)
+		pos := related.End()
+		if related.Comment != nil {
+			pos = related.Comment.End()
+		}
+		setImportSpecPos(spec, pos)
+		if !gen.Lparen.IsValid() {
+			gen.Lparen = gen.Specs[0].Pos()
+		}
+		if gen.Rparen < pos {
+			gen.Rparen = pos
+		}
+	}
+
+	gen.Specs = append(gen.Specs, spec)
+	f.Imports = append(f.Imports, spec)
+	return spec
+}
+
+// relatedImportSpec returns the spec in gen that the import of p should be placed after.
+// It's the last one that shares the most path elements with p among imports of the same kind
+// (standard packages or not), or the last spec if there is no import of the same kind.
+func relatedImportSpec(gen *ast.GenDecl, p string) *ast.ImportSpec {
+	var related *ast.ImportSpec
+	shared := -1
+	for _, s := range gen.Specs {
+		spec := s.(*ast.ImportSpec)
+		q := unquote(spec.Path.Value)
+		if isStdImportPath(q) != isStdImportPath(p) {
+			continue
+		}
+
+		n := 0
+		for a, b := strings.Split(p, "/"), strings.Split(q, "/"); n < len(a) && n < len(b) && a[n] == b[n]; n++ {
+		}
+		if n >= shared {
+			related, shared = spec, n
+		}
+	}
+
+	if related == nil {
+		return gen.Specs[len(gen.Specs)-1].(*ast.ImportSpec)
+	}
+	return related
+}
+
+// deleteImportSpecFromFile deletes spec from the declaration that has it.
+func deleteImportSpecFromFile(f *ast.File, spec *ast.ImportSpec) {
+	for i, decl := range f.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.IMPORT {
+			continue
+		}
+
+		for j, s := range gen.Specs {
+			if s != spec {
+				continue
+			}
+
+			if len(gen.Specs) == 1 {
+				f.Decls = append(f.Decls[:i], f.Decls[i+1:]...)
+				deleteDeclComments(f, gen)
+			} else {
+				gen.Specs = append(gen.Specs[:j], gen.Specs[j+1:]...)
+				deleteSpecComments(f, spec)
+			}
+
+			for k, imp := range f.Imports {
+				if imp == spec {
+					f.Imports = append(f.Imports[:k], f.Imports[k+1:]...)
+					break
+				}
+			}
+			return
+		}
+	}
+}
+
+// deleteSpecComments deletes the doc and line comments of spec from f.
+func deleteSpecComments(f *ast.File, spec *ast.ImportSpec) {
+	deleteComments(f, func(cg *ast.CommentGroup) bool {
+		return cg == spec.Doc || cg == spec.Comment
+	})
+}
+
+// deleteDeclComments deletes the doc comment of gen and all comments in gen from f.
+// gen may have no specs already.
+func deleteDeclComments(f *ast.File, gen *ast.GenDecl) {
+	// gen.End() can't be used for `import "fmt"` that has no specs
+	end := gen.TokPos
+	if gen.Rparen.IsValid() {
+		end = gen.Rparen
+	}
+
+	deleteComments(f, func(cg *ast.CommentGroup) bool {
+		return cg == gen.Doc || (gen.Pos() <= cg.Pos() && cg.End() <= end)
+	})
+}
+
+func deleteComments(f *ast.File, match func(*ast.CommentGroup) bool) {
+	comments := []*ast.CommentGroup{}
+	for _, cg := range f.Comments {
+		if !match(cg) {
+			comments = append(comments, cg)
+		}
+	}
+	f.Comments = comments
+}
+
+// importSpecs returns import specs in declarations of f.
+// Unlike f.Imports, it reflects specs deleted from declarations.
+func importSpecs(f *ast.File) []*ast.ImportSpec {
+	specs := []*ast.ImportSpec{}
+	for _, decl := range f.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.IMPORT {
+			continue
+		}
+
+		for _, spec := range gen.Specs {
+			specs = append(specs, spec.(*ast.ImportSpec))
+		}
+	}
+
+	return specs
+}
+
+func setImportSpecPos(spec *ast.ImportSpec, pos token.Pos) {
+	if spec.Name != nil {
+		spec.Name.NamePos = pos
+	}
+	spec.Path.ValuePos = pos
+	spec.EndPos = pos
+}
+
+func isCImportDecl(gen *ast.GenDecl) bool {
+	for _, spec := range gen.Specs {
+		if unquote(spec.(*ast.ImportSpec).Path.Value) == "C" {
+			return true
+		}
+	}
+	return false
+}
+
+// formatImportDecl renders gen as source text.
+//
+// Unlike ast.SortImports, specs are sorted together with their doc and line comments.
+// Specs are sorted within each group separated by blank lines or comments
+// that don't belong to any spec, or regrouped by cfg.Group if it's enabled.
+// Duplicated specs (same name and path) are merged.
+func formatImportDecl(fset *token.FileSet, f *ast.File, gen *ast.GenDecl, src []byte, cfg *config) []byte {
+	var buf bytes.Buffer
+
+	if !gen.Lparen.IsValid() && len(gen.Specs) == 1 {
+		// the line comment is placed after the declaration and left in the source
+		spec := gen.Specs[0].(*ast.ImportSpec)
+		buf.WriteString("import ")
+		if spec.Name != nil {
+			buf.WriteString(spec.Name.Name + " ")
+		}
+		buf.WriteString(spec.Path.Value)
+		return buf.Bytes()
+	}
+
+	groups := importGroups(fset, f, gen, src)
+	if cfg.Group.Regroup {
+		groups = regroupImports(groups, &cfg.Group)
+	}
+
+	// import (        ->  import "fmt" // comment
+	//     "fmt" // comment
+	// )
+	if cfg.Decl.Collapse && len(groups) == 1 && groups[0].comment == nil && len(groups[0].specs) == 1 && groups[0].specs[0].Doc == nil {
+		buf.WriteString("import ")
+		writeImportSpec(&buf, groups[0].specs[0], "")
+		return bytes.TrimSuffix(buf.Bytes(), []byte("\n"))
+	}
+
+	buf.WriteString("import (\n")
+	for i, group := range groups {
+		if i > 0 && group.blankBefore {
+			buf.WriteString("\n")
+		}
+
+		if group.comment != nil {
+			for _, c := range group.comment.List {
+				buf.WriteString("\t" + c.Text + "\n")
+			}
+			continue
+		}
+
+		for _, spec := range sortImportSpecs(group.specs) {
+			writeImportSpec(&buf, spec, "\t")
+		}
+	}
+	buf.WriteString(")")
+
+	return buf.Bytes()
+}
+
+// mergeImportDecls merges import declarations into the first one, and deletes empty import declarations.
+// `import "C"` is never merged: declarations after it are merged into the one before it.
+//
+//	import "fmt"      ->  import (
+//	import (                  "fmt"
+//		"os"                  "os"
+//	)                     )
+//
+// The Rparen of the merged declaration is moved to the end of the last merged one,
+// so comments between the declarations are kept in it.
+func mergeImportDecls(fset *token.FileSet, f *ast.File) {
+	decls := []ast.Decl{}
+	var target *ast.GenDecl
+	// afterC is true if `import "C"` is between target and the current declaration
+	afterC := false
+	for _, decl := range f.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.IMPORT {
+			target = nil
+			decls = append(decls, decl)
+			continue
+		}
+
+		if len(gen.Specs) == 0 {
+			deleteDeclComments(f, gen)
+			continue
+		}
+
+		if isCImportDecl(gen) {
+			afterC = target != nil
+			decls = append(decls, decl)
+			continue
+		}
+
+		if target == nil {
+			target = gen
+			decls = append(decls, decl)
+			continue
+		}
+
+		// import "os" // comment  ->  // doc
+		//                             "os" // comment
+		if !gen.Lparen.IsValid() {
+			spec := gen.Specs[0].(*ast.ImportSpec)
+			if spec.Doc == nil {
+				spec.Doc = gen.Doc
+			}
+		}
+
+		if !target.Lparen.IsValid() {
+			target.Lparen = target.Specs[0].Pos()
+			target.Rparen = target.End() - 1
+			if spec := target.Specs[0].(*ast.ImportSpec); spec.Comment != nil {
+				target.Rparen = spec.Comment.End()
+			}
+		}
+		target.Specs = append(target.Specs, gen.Specs...)
+		if afterC {
+			// the declaration is dropped, and `import "C"` stays between them
+			deleteDeclComments(f, gen)
+			continue
+		}
+		target.Rparen = gen.End() - 1
+		if gen.Rparen.IsValid() {
+			target.Rparen = gen.Rparen
+		} else if spec := gen.Specs[0].(*ast.ImportSpec); spec.Comment != nil {
+			target.Rparen = spec.Comment.End()
+		}
+	}
+
+	f.Decls = decls
+}
+
+// importGroup is a run of specs or a floating comment in an import declaration.
+type importGroup struct {
+	specs       []*ast.ImportSpec
+	comment     *ast.CommentGroup
+	blankBefore bool
+}
+
+func importGroups(fset *token.FileSet, f *ast.File, gen *ast.GenDecl, src []byte) []*importGroup {
+	// hasBlankLine reports whether there is a blank line between a and b in src.
+	// Lines of deleted specs are not blank.
+	hasBlankLine := func(a, b token.Pos) bool {
+		return blankLineRe.Match(src[fset.Position(a).Offset:fset.Position(b).Offset])
+	}
+
+	attached := map[*ast.CommentGroup]bool{}
+	for _, spec := range gen.Specs {
+		spec := spec.(*ast.ImportSpec)
+		attached[spec.Doc] = true
+		attached[spec.Comment] = true
+	}
+
+	// items in source order
+	type item struct {
+		spec       *ast.ImportSpec
+		comment    *ast.CommentGroup
+		start, end token.Pos
+	}
+	items := []item{}
+	for _, spec := range gen.Specs {
+		spec := spec.(*ast.ImportSpec)
+		it := item{spec: spec, start: spec.Pos(), end: spec.End()}
+		if spec.Doc != nil {
+			it.start = spec.Doc.Pos()
+		}
+		if spec.Comment != nil {
+			it.end = spec.Comment.End()
+		}
+		items = append(items, it)
+	}
+	for _, cg := range f.Comments {
+		if gen.Lparen < cg.Pos() && cg.End() < gen.Rparen && !attached[cg] {
+			items = append(items, item{comment: cg, start: cg.Pos(), end: cg.End()})
+		}
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].start < items[j].start
+	})
+
+	groups := []*importGroup{}
+	var last *item
+	for i := range items {
+		it := &items[i]
+		blank := last != nil && hasBlankLine(last.end, it.start)
+
+		switch {
+		case it.comment != nil:
+			groups = append(groups, &importGroup{comment: it.comment, blankBefore: blank})
+		case last == nil || last.comment != nil || blank:
+			groups = append(groups, &importGroup{specs: []*ast.ImportSpec{it.spec}, blankBefore: blank})
+		default:
+			g := groups[len(groups)-1]
+			g.specs = append(g.specs, it.spec)
+		}
+
+		last = it
+	}
+
+	return groups
+}
+
+// regroupImports puts specs into groups by g.
+// Comments that don't belong to any spec are placed after the groups.
+func regroupImports(groups []*importGroup, g *groupConfig) []*importGroup {
+	byIndex := map[int]*importGroup{}
+	comments := []*importGroup{}
+	for _, group := range groups {
+		if group.comment != nil {
+			comments = append(comments, &importGroup{comment: group.comment, blankBefore: true})
+			continue
+		}
+
+		for _, spec := range group.specs {
+			i := g.groupOf(unquote(spec.Path.Value))
+			if byIndex[i] == nil {
+				byIndex[i] = &importGroup{blankBefore: true}
+			}
+			byIndex[i].specs = append(byIndex[i].specs, spec)
+		}
+	}
+
+	indexes := []int{}
+	for i := range byIndex {
+		indexes = append(indexes, i)
+	}
+	sort.Ints(indexes)
+
+	regrouped := []*importGroup{}
+	for _, i := range indexes {
+		regrouped = append(regrouped, byIndex[i])
+	}
+	return append(regrouped, comments...)
+}
+
+// sortImportSpecs sorts specs by path and name as ast.SortImports does,
+// and removes duplicated specs.
+func sortImportSpecs(specs []*ast.ImportSpec) []*ast.ImportSpec {
+	name := func(s *ast.ImportSpec) string {
+		if s.Name == nil {
+			return ""
+		}
+		return s.Name.Name
+	}
+
+	sort.SliceStable(specs, func(i, j int) bool {
+		pi, pj := unquote(specs[i].Path.Value), unquote(specs[j].Path.Value)
+		if pi != pj {
+			return pi < pj
+		}
+		return name(specs[i]) < name(specs[j])
+	})
+
+	uniq := []*ast.ImportSpec{}
+	for i, s := range specs {
+		if i > 0 && unquote(s.Path.Value) == unquote(specs[i-1].Path.Value) && name(s) == name(specs[i-1]) {
+			continue
+		}
+		uniq = append(uniq, s)
+	}
+
+	return uniq
+}
+
+func writeImportSpec(buf *bytes.Buffer, spec *ast.ImportSpec, indent string) {
+	if spec.Doc != nil {
+		for _, c := range spec.Doc.List {
+			buf.WriteString(indent + c.Text + "\n")
+		}
+	}
+
+	buf.WriteString(indent)
+	if spec.Name != nil {
+		buf.WriteString(spec.Name.Name + " ")
+	}
+	buf.WriteString(spec.Path.Value)
+	if spec.Comment != nil {
+		for _, c := range spec.Comment.List {
+			buf.WriteString(" " + c.Text)
+		}
+	}
+	buf.WriteString("\n")
+}
@@ -17,11 +17,43 @@ Usage:
 
 The commands are:
 
-	importable             show import paths of importable packages
-	dropable [path]        show import paths of dropable packages in file
-	unused [path]          show import paths of unused packages in file.
+	importable [-go version] [-deprecated annotate|hide]
+	                       show import paths of importable packages, except standard packages
+	                       newer than the go directive in go.mod.
+	which [pkgname.]Ident  show import paths of packages that export Ident, with its kind and signature,
+	                       except standard packages and symbols newer than the go directive in go.mod.
+	dropable [-names] [path]
+	                       show import paths of dropable packages in file
+	unused [-names] [path] show import paths of unused packages in file.
+	                       -names shows "name path" to tell imports of the same path apart.
+	explain [path]         show how the package name of each import is resolved and where it is used.
 	fmt [flags] [paths...] drop unused packages and format file(ast as gofmt).
 	                       if you want to know options for goimps fmt, please run "goimps fmt -h".
+	check [paths...]       report imports that violate the rules in .goimps.json,
+	                       standard packages and symbols newer than the go directive in go.mod,
+	                       and imports that can't be resolved with suggestions of similar import paths.
+	add [-as alias] [-w] path [file]
+	                       add the import of path to file, with an alias if the package name is already used.
+	drop [-w] file paths...
+	                       drop the imports of paths from file (- for standard input) even if they are used,
+	                       and report selectors that referred to them.
+	alias [-w] file path name
+	                       rename the import of path in file (- for standard input) to name ("" to drop the alias),
+	                       and rewrite selectors that use the old name.
+	replace [-d] old new paths...
+	                       rewrite imports of old and its subpackages to new, keeping the package names.
+	mvsym [-d] oldpath.Name newpath.Name paths...
+	                       rewrite references to oldpath.Name to newpath.Name, adding and dropping imports.
+	pkgrename [-d] dir newname [paths...]
+	                       rename the package in dir and update its importers in paths (default: the module),
+	                       rewriting selectors of imports without an alias.
+	modernize [-d] [-go version] paths...
+	                       rewrite references to deprecated packages like io/ioutil to their standard equivalents
+	                       that the go directive in go.mod allows, and fix the imports.
+	deprecated paths...    report imports of deprecated packages and packages in deprecated modules.
+	aliases [-fix] paths...
+	                       report import paths that are imported with different aliases.
+	                       "dir/..." means Go files in dir and its subdirectories.
 
 `
 	fmt.Fprintf(os.Stderr, banner)
@@ -44,13 +76,37 @@ func main() {
 
 	switch flag.Arg(0) {
 	case "importable":
-		exitCode = cmdImportable(os.Stdout, os.Stderr)
+		exitCode = cmdImportable(os.Stdout, os.Stderr, flag.Args()[1:])
+	case "which":
+		exitCode = cmdWhich(os.Stdout, os.Stderr, flag.Arg(1))
 	case "dropable":
-		exitCode = cmdDropable(os.Stdin, os.Stdout, os.Stderr, flag.Arg(1))
+		exitCode = cmdDropable(os.Stdin, os.Stdout, os.Stderr, flag.Args()[1:])
 	case "unused":
-		exitCode = cmdUnused(os.Stdin, os.Stdout, os.Stderr, flag.Arg(1))
+		exitCode = cmdUnused(os.Stdin, os.Stdout, os.Stderr, flag.Args()[1:])
+	case "explain":
+		exitCode = cmdExplain(os.Stdin, os.Stdout, os.Stderr, flag.Arg(1))
 	case "fmt":
 		exitCode = cmdFmt(os.Stdin, os.Stdout, os.Stderr, flag.Args()[1:])
+	case "check":
+		exitCode = cmdCheck(os.Stdin, os.Stdout, os.Stderr, flag.Args()[1:])
+	case "add":
+		exitCode = cmdAdd(os.Stdin, os.Stdout, os.Stderr, flag.Args()[1:])
+	case "drop":
+		exitCode = cmdDrop(os.Stdin, os.Stdout, os.Stderr, flag.Args()[1:])
+	case "alias":
+		exitCode = cmdAlias(os.Stdin, os.Stdout, os.Stderr, flag.Args()[1:])
+	case "replace":
+		exitCode = cmdReplace(os.Stdout, os.Stderr, flag.Args()[1:])
+	case "mvsym":
+		exitCode = cmdMvsym(os.Stdout, os.Stderr, flag.Args()[1:])
+	case "pkgrename":
+		exitCode = cmdPkgrename(os.Stdout, os.Stderr, flag.Args()[1:])
+	case "modernize":
+		exitCode = cmdModernize(os.Stdout, os.Stderr, flag.Args()[1:])
+	case "deprecated":
+		exitCode = cmdDeprecated(os.Stdout, os.Stderr, flag.Args()[1:])
+	case "aliases":
+		exitCode = cmdAliases(os.Stdout, os.Stderr, flag.Args()[1:])
 	default:
 		flag.Usage()
 		exitCode = 2
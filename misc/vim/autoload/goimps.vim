@@ -11,6 +11,21 @@ function! goimps#Importable()
   return split(s, '\n')
 endfunction
 
+function! goimps#Which(ident)
+  let s = system('goimps which ' . shellescape(a:ident))
+  if v:shell_error
+    echoerr '[ERROR] goimps: errors occur on excuting `goimps which`'  . shellescape(a:ident)
+    return []
+  endif
+
+  let symbols = []
+  for l in split(s, '\n')
+    let [path, kind, sig] = split(l, '\t')
+    call add(symbols, {'path': path, 'kind': kind, 'signature': sig})
+  endfor
+  return symbols
+endfunction
+
 function! goimps#Dropable(filename)
   let s = system('goimps dropable ' . shellescape(a:filename))
   if v:shell_error
@@ -31,5 +46,38 @@ function! goimps#Unused(filename)
   return split(s, '\n')
 endfunction
 
+" goimps#Add adds the import of path to the current buffer.
+" The optional argument is the alias of the import.
+function! goimps#Add(path, ...)
+  let cmd = 'goimps add '
+  if a:0 > 0 && a:1 != ''
+    let cmd .= '-as ' . shellescape(a:1) . ' '
+  endif
+  let s = system(cmd . shellescape(a:path), join(getline(1, '$'), "\n") . "\n")
+  if v:shell_error
+    echoerr '[ERROR] goimps: errors occur on excuting `goimps add`'  . shellescape(a:path) . ': ' . s
+    return
+  endif
+
+  let view = winsaveview()
+  silent! %delete _
+  call setline(1, split(s, '\n', 1)[:-2])
+  call winrestview(view)
+endfunction
+
+" goimps#Drop drops the imports of paths from the current buffer even if they are used.
+function! goimps#Drop(...)
+  let s = system('goimps drop - ' . join(map(copy(a:000), 'shellescape(v:val)'), ' ') . ' 2>/dev/null', join(getline(1, '$'), "\n") . "\n")
+  if v:shell_error
+    echoerr '[ERROR] goimps: errors occur on excuting `goimps drop`'  . join(a:000, ' ')
+    return
+  endif
+
+  let view = winsaveview()
+  silent! %delete _
+  call setline(1, split(s, '\n', 1)[:-2])
+  call winrestview(view)
+endfunction
+
 let &cpo = s:save_cpo
 unlet s:save_cpo
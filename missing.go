@@ -0,0 +1,317 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// missingRef is a package name that is used in selectors but isn't imported.
+type missingRef struct {
+	name string
+	sels []string // referenced identifiers: strings.Fields -> Fields
+}
+
+// findMissing returns package names that are referenced in f but not imported.
+// siblings are names declared at the package level in other files of the same package.
+func findMissing(f *ast.File, imps []imp, siblings map[string]bool) []missingRef {
+	imported := map[string]bool{}
+	for _, i := range imps {
+		imported[i.name] = true
+	}
+	for _, spec := range importSpecs(f) {
+		if spec.Name != nil {
+			imported[spec.Name.Name] = true
+		}
+	}
+
+	missing := []missingRef{}
+	found := map[string]int{}
+	ast.Inspect(f, func(n ast.Node) bool {
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		xid, ok := sel.X.(*ast.Ident)
+		if !ok || xid.Obj != nil {
+			// if the parser can resolve it, it's not a package ref
+			return true
+		}
+		if imported[xid.Name] || siblings[xid.Name] || f.Scope.Lookup(xid.Name) != nil || !ast.IsExported(sel.Sel.Name) {
+			return true
+		}
+
+		if i, ok := found[xid.Name]; ok {
+			for _, s := range missing[i].sels {
+				if s == sel.Sel.Name {
+					return true
+				}
+			}
+			missing[i].sels = append(missing[i].sels, sel.Sel.Name)
+			return true
+		}
+		found[xid.Name] = len(missing)
+		missing = append(missing, missingRef{name: xid.Name, sels: []string{sel.Sel.Name}})
+		return true
+	})
+
+	return missing
+}
+
+// siblingFiles returns package level names and import paths in other files
+// of the package that filename belongs to.
+func siblingFiles(filename, pkgname string) (map[string]bool, map[string]bool) {
+	names := map[string]bool{}
+	paths := map[string]bool{}
+
+	if _, err := os.Stat(filename); err != nil {
+		// standard input
+		return names, paths
+	}
+
+	dir := filepath.Dir(filename)
+	matches, _ := filepath.Glob(filepath.Join(dir, "*.go"))
+	for _, m := range matches {
+		if filepath.Base(m) == filepath.Base(filename) {
+			continue
+		}
+
+		f, err := parser.ParseFile(token.NewFileSet(), m, nil, parser.Mode(0))
+		if err != nil || f.Name.Name != pkgname {
+			continue
+		}
+
+		sf := newSiblingFile(f)
+		for name := range sf.names {
+			names[name] = true
+		}
+		for p := range sf.paths {
+			paths[p] = true
+		}
+	}
+
+	return names, paths
+}
+
+// siblingFile is the package level names and import paths of a file.
+type siblingFile struct {
+	pkgname string
+	names   map[string]bool
+	paths   map[string]bool
+}
+
+func newSiblingFile(f *ast.File) *siblingFile {
+	sf := &siblingFile{pkgname: f.Name.Name, names: map[string]bool{}, paths: map[string]bool{}}
+	for name := range f.Scope.Objects {
+		sf.names[name] = true
+	}
+	for _, spec := range f.Imports {
+		sf.paths[unquote(spec.Path.Value)] = true
+	}
+	return sf
+}
+
+// packageExports returns the package name and exported package level names of the package in dir.
+func packageExports(dir string) (string, map[string]bool, error) {
+	name, syms, err := packageSymbols(dir)
+	if err != nil {
+		return "", nil, err
+	}
+
+	exports := map[string]bool{}
+	for _, s := range syms {
+		exports[s.name] = true
+	}
+
+	return name, exports, nil
+}
+
+// importIndex is the importable packages and their exports.
+// A run of goimps fmt shares one among files, so packages are listed and parsed at most once.
+// The zero value is ready to use, and lists packages on first use.
+type importIndex struct {
+	pkgs     []importable
+	exports  map[string]*pkgExports             // by directory
+	siblings map[string]map[string]*siblingFile // by directory, then by file name
+	imports  map[string]map[string]int          // counts of import paths by root
+}
+
+type pkgExports struct {
+	name    string
+	exports map[string]bool
+	err     error
+}
+
+// importable returns the importable packages.
+func (x *importIndex) importable() []importable {
+	if x.pkgs == nil {
+		// errors are ignored: packages that are found are still useful
+		x.pkgs, _ = listImportable(false)
+	}
+	return x.pkgs
+}
+
+// packageExports is packageExports(dir), but the package is parsed only once.
+func (x *importIndex) packageExports(dir string) (string, map[string]bool, error) {
+	if x.exports == nil {
+		x.exports = map[string]*pkgExports{}
+	}
+
+	e, ok := x.exports[dir]
+	if !ok {
+		e = &pkgExports{}
+		e.name, e.exports, e.err = packageExports(dir)
+		x.exports[dir] = e
+	}
+	return e.name, e.exports, e.err
+}
+
+// siblingFiles is siblingFiles(filename, pkgname), but files in a directory are parsed only once.
+func (x *importIndex) siblingFiles(filename, pkgname string) (map[string]bool, map[string]bool) {
+	names := map[string]bool{}
+	paths := map[string]bool{}
+
+	if _, err := os.Stat(filename); err != nil {
+		// standard input
+		return names, paths
+	}
+
+	dir := filepath.Dir(filename)
+	if x.siblings == nil {
+		x.siblings = map[string]map[string]*siblingFile{}
+	}
+	files, ok := x.siblings[dir]
+	if !ok {
+		files = map[string]*siblingFile{}
+		matches, _ := filepath.Glob(filepath.Join(dir, "*.go"))
+		for _, m := range matches {
+			if f, err := parser.ParseFile(token.NewFileSet(), m, nil, parser.Mode(0)); err == nil {
+				files[filepath.Base(m)] = newSiblingFile(f)
+			}
+		}
+		x.siblings[dir] = files
+	}
+
+	for name, sf := range files {
+		if name == filepath.Base(filename) || sf.pkgname != pkgname {
+			continue
+		}
+		for n := range sf.names {
+			names[n] = true
+		}
+		for p := range sf.paths {
+			paths[p] = true
+		}
+	}
+
+	return names, paths
+}
+
+// updateSiblingFile replaces the names and imports of filename known to x with ones of f,
+// so that later files see f as it's formatted.
+func (x *importIndex) updateSiblingFile(filename string, f *ast.File) {
+	if files, ok := x.siblings[filepath.Dir(filename)]; ok {
+		if _, ok := files[filepath.Base(filename)]; ok {
+			files[filepath.Base(filename)] = newSiblingFile(f)
+		}
+	}
+}
+
+// importCounts is countImports(root), but root is walked only once.
+func (x *importIndex) importCounts(root string) map[string]int {
+	if x.imports == nil {
+		x.imports = map[string]map[string]int{}
+	}
+
+	counts, ok := x.imports[root]
+	if !ok {
+		counts = countImports(root)
+		x.imports[root] = counts
+	}
+	return counts
+}
+
+// findImportCandidates returns import paths of packages in x named ref.name that export all of ref.sels.
+// Standard packages and symbols newer than goVersion are excluded.
+func findImportCandidates(ref missingRef, x *importIndex, goVersion string) []string {
+	candidates := []string{}
+	for _, pkg := range x.importable() {
+		if !isVisibleImportPath(pkg.path) || !isStdAvailable(pkg.path, "", goVersion) {
+			continue
+		}
+		if path := pkg.path; filepath.Base(path) != ref.name && inferPackageName(path) != ref.name {
+			continue
+		}
+
+		name, exports, err := x.packageExports(pkg.dir)
+		if err != nil || name != ref.name {
+			continue
+		}
+
+		ok := true
+		for _, sel := range ref.sels {
+			ok = ok && exports[sel] && isStdAvailable(pkg.path, sel, goVersion)
+		}
+		if ok {
+			candidates = append(candidates, pkg.path)
+		}
+	}
+
+	return candidates
+}
+
+// isStdImportPath reports whether p looks like a path of standard packages.
+func isStdImportPath(p string) bool {
+	return !strings.Contains(strings.SplitN(p, "/", 2)[0], ".")
+}
+
+// isVisibleImportPath reports whether the package of p can be imported by others.
+func isVisibleImportPath(p string) bool {
+	for _, elem := range strings.Split(p, "/") {
+		if elem == "internal" || elem == "vendor" {
+			return false
+		}
+	}
+
+	return !strings.HasPrefix(p, "cmd/")
+}
+
+// addMissingImports adds imports of packages in x referenced in f but not imported.
+// If several packages match a name, one is chosen by cfg.Resolve.
+// It returns diagnostics for names that can't be resolved.
+func addMissingImports(fset *token.FileSet, f *ast.File, filename string, imps []imp, cfg *config, x *importIndex) []string {
+	siblings, siblingPaths := x.siblingFiles(filename, f.Name.Name)
+	defer x.updateSiblingFile(filename, f)
+
+	missing := findMissing(f, imps, siblings)
+	if len(missing) == 0 {
+		return nil
+	}
+
+	r := newRanker(cfg, filename, siblingPaths, x)
+	goVersion := targetGoVersion(configDir(filename))
+
+	diags := []string{}
+	for _, ref := range missing {
+		if p, ok := cfg.Resolve.Overrides[ref.name]; ok {
+			addImportSpec(fset, f, "", p)
+			continue
+		}
+
+		candidates, ambiguous := r.rank(findImportCandidates(ref, x, goVersion))
+		switch {
+		case len(candidates) == 0:
+			diags = append(diags, fmt.Sprintf("%s: no importable package is found for %s", filename, ref.name))
+		case ambiguous && cfg.Resolve.Ambiguous == "report":
+			diags = append(diags, fmt.Sprintf("%s: %s is ambiguous: %s", filename, ref.name, strings.Join(candidates, ", ")))
+		default:
+			addImportSpec(fset, f, "", candidates[0])
+		}
+	}
+
+	return diags
+}
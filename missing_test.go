@@ -0,0 +1,141 @@
+package main
+
+import (
+	"bytes"
+	"go/build"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"testing"
+)
+
+func TestCmdFmtAddMissing(t *testing.T) {
+	defer useTestImportPaths("addgopath")()
+
+	tests := []struct {
+		in       string
+		expected string
+	}{
+		{
+			in: `package main
+
+import "os"
+
+func main() {
+	fmt.Println(strings.Fields(""), os.Args, rand.Read, rand.Prime)
+}
+`,
+			expected: `package main
+
+import (
+	"crypto/rand"
+	"fmt"
+	"os"
+	"strings"
+)
+
+func main() {
+	fmt.Println(strings.Fields(""), os.Args, rand.Read, rand.Prime)
+}
+`,
+		},
+		{
+			in: `package main
+
+func main() {
+	_ = rand.Intn(10)
+	_ = notfound.Foo
+}
+`,
+			expected: `package main
+
+import "math/rand"
+
+func main() {
+	_ = rand.Intn(10)
+	_ = notfound.Foo
+}
+`,
+		},
+	}
+
+	for _, test := range tests {
+		stdout := &bytes.Buffer{}
+		stderr := &bytes.Buffer{}
+		if cmdFmt(bytes.NewReader([]byte(test.in)), stdout, stderr, []string{"-A"}) != 0 {
+			t.Errorf("goimps fmt should not fail: %s", stderr.String())
+			continue
+		}
+
+		got := stdout.String()
+		if got != test.expected {
+			t.Errorf("goimps fmt -A should add missing imports\n---expected--- \n`%s`\n--- got --- \n`%s`", test.expected, got)
+		}
+	}
+
+	// packages imported by other files in the same package are preferred
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	if cmdFmt(nil, stdout, stderr, []string{"-A", filepath.Join("testdata", "addmissing", "b.go")}) != 0 {
+		t.Errorf("goimps fmt should not fail: %s", stderr.String())
+	}
+	expected := `package addmissing
+
+import "example.com/rand"
+
+func f() int {
+	return rand.Intn(n)
+}
+`
+	if got := stdout.String(); got != expected {
+		t.Errorf("goimps fmt -A should prefer imports in the same package\n---expected--- \n`%s`\n--- got --- \n`%s`", expected, got)
+	}
+}
+
+func TestImportIndex(t *testing.T) {
+	dir := filepath.Join(build.Default.GOROOT, "src", "strings")
+	x := &importIndex{pkgs: []importable{{path: "strings", dir: dir}}}
+
+	if got := findImportCandidates(missingRef{name: "strings", sels: []string{"Fields"}}, x, "1.21"); len(got) != 1 || got[0] != "strings" {
+		t.Errorf("expected strings for strings.Fields, but got %v", got)
+	}
+	if _, ok := x.exports[dir]; !ok {
+		t.Fatalf("exports of %s should be cached", dir)
+	}
+
+	// packages are parsed only once
+	x.exports[dir].exports["Fake"] = true
+	if got := findImportCandidates(missingRef{name: "strings", sels: []string{"Fake"}}, x, "1.21"); len(got) != 1 || got[0] != "strings" {
+		t.Errorf("exports of strings should be read from the index, but got %v", got)
+	}
+}
+
+func TestImportIndexSiblingFiles(t *testing.T) {
+	dir := filepath.Join("testdata", "addmissing")
+	x := &importIndex{}
+
+	names, paths := x.siblingFiles(filepath.Join(dir, "b.go"), "addmissing")
+	if !names["n"] || names["f"] || !paths["example.com/rand"] {
+		t.Errorf("unexpected siblings of b.go: %v %v", names, paths)
+	}
+	names, paths = x.siblingFiles(filepath.Join(dir, "a.go"), "addmissing")
+	if names["n"] || !names["f"] || len(paths) != 0 {
+		t.Errorf("unexpected siblings of a.go: %v %v", names, paths)
+	}
+
+	// files are parsed only once
+	x.siblings[dir]["a.go"].paths["example.com/fake"] = true
+	if _, paths := x.siblingFiles(filepath.Join(dir, "b.go"), "addmissing"); !paths["example.com/fake"] {
+		t.Errorf("siblings of b.go should be read from the index, but got %v", paths)
+	}
+
+	// formatted files replace ones in the index
+	f, err := parser.ParseFile(token.NewFileSet(), "", "package addmissing\n\nimport \"math/rand\"\n\nvar n = rand.Intn(10)\n", parser.Mode(0))
+	if err != nil {
+		panic(err)
+	}
+	x.updateSiblingFile(filepath.Join(dir, "a.go"), f)
+	if _, paths := x.siblingFiles(filepath.Join(dir, "b.go"), "addmissing"); len(paths) != 1 || !paths["math/rand"] {
+		t.Errorf("siblings of b.go should be updated, but got %v", paths)
+	}
+}
@@ -0,0 +1,128 @@
+//go:build ignore
+// +build ignore
+
+// mkstdversions generates stdversions.go from the API files in $GOROOT/api,
+// which record the Go release that added each exported symbol of standard packages.
+//
+//	$ go run mkstdversions.go
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/build"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// pkg net/http, func Get(string) (*Response, error)
+// pkg syscall (linux-386), const AF_ALG = 38
+var apiLineRe = regexp.MustCompile(`^pkg ([^ ,]+)(?: \([^)]*\))?, (func|type|var|const) ([A-Za-z0-9_]+)`)
+
+var apiFileRe = regexp.MustCompile(`^go(1(?:\.\d+)?)\.txt$`)
+
+func main() {
+	files, err := filepath.Glob(filepath.Join(build.Default.GOROOT, "api", "go1*.txt"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	pkgs := map[string]string{}
+	symbols := map[string]string{}
+	for _, filename := range files {
+		m := apiFileRe.FindStringSubmatch(filepath.Base(filename))
+		if m == nil {
+			continue
+		}
+		version := m[1]
+		if version == "1" {
+			version = "1.0"
+		}
+
+		if err := readAPIFile(filename, version, pkgs, symbols); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	// symbols added with their package are known by the package version
+	for sym, version := range symbols {
+		if pkgs[sym[:strings.LastIndex(sym, ".")]] == version {
+			delete(symbols, sym)
+		}
+	}
+
+	buf := &bytes.Buffer{}
+	fmt.Fprintln(buf, "// Code generated by mkstdversions.go; DO NOT EDIT.")
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, "package main")
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, "// stdPackageVersions maps standard packages to the Go release that added them.")
+	writeMap(buf, "stdPackageVersions", pkgs)
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, "// stdSymbolVersions maps exported symbols (path.Name) of standard packages")
+	fmt.Fprintln(buf, "// to the Go release that added them, if it's later than their package.")
+	writeMap(buf, "stdSymbolVersions", symbols)
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile("stdversions.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func readAPIFile(filename, version string, pkgs, symbols map[string]string) error {
+	f, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		m := apiLineRe.FindStringSubmatch(s.Text())
+		if m == nil {
+			continue
+		}
+
+		setEarlier(pkgs, m[1], version)
+		setEarlier(symbols, m[1]+"."+m[3], version)
+	}
+
+	return s.Err()
+}
+
+// setEarlier sets version to m[key] if it's earlier than the current one.
+func setEarlier(m map[string]string, key, version string) {
+	if current, ok := m[key]; !ok || minor(version) < minor(current) {
+		m[key] = version
+	}
+}
+
+func minor(version string) int {
+	n, _ := strconv.Atoi(strings.TrimPrefix(version, "1."))
+	return n
+}
+
+func writeMap(buf *bytes.Buffer, name string, m map[string]string) {
+	keys := []string{}
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	fmt.Fprintf(buf, "var %s = map[string]string{\n", name)
+	for _, k := range keys {
+		fmt.Fprintf(buf, "%q: %q,\n", k, m[k])
+	}
+	fmt.Fprintln(buf, "}")
+}
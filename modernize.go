@@ -0,0 +1,119 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+)
+
+var (
+	modernizeFlag = flag.NewFlagSet("goimps modernize flags", 2)
+	modernizeDiff = modernizeFlag.Bool("d", false, "display diffs instead of rewriting files")
+	modernizeGo   = modernizeFlag.String("go", "", "Go version that rewrites must be allowed by (default: the go directive in go.mod)")
+)
+
+// builtinModernizations are the rewrites of deprecated or superseded packages to the standard library.
+// Only symbols with the same signature in both packages are listed.
+var builtinModernizations = append([]modernization{
+	{From: "io/ioutil.Discard", To: "io.Discard", Go: "1.16"},
+	{From: "io/ioutil.NopCloser", To: "io.NopCloser", Go: "1.16"},
+	{From: "io/ioutil.ReadAll", To: "io.ReadAll", Go: "1.16"},
+	{From: "io/ioutil.ReadFile", To: "os.ReadFile", Go: "1.16"},
+	{From: "io/ioutil.WriteFile", To: "os.WriteFile", Go: "1.16"},
+	{From: "io/ioutil.TempDir", To: "os.MkdirTemp", Go: "1.16"},
+	{From: "io/ioutil.TempFile", To: "os.CreateTemp", Go: "1.16"},
+	{From: "golang.org/x/exp/constraints.Ordered", To: "cmp.Ordered", Go: "1.21"},
+},
+	append(
+		sameNames("golang.org/x/exp/slices", "slices", "1.21",
+			"BinarySearch", "Clip", "Clone", "Compact", "CompactFunc", "Contains", "ContainsFunc",
+			"Delete", "DeleteFunc", "Equal", "EqualFunc", "Grow", "Index", "IndexFunc",
+			"Insert", "IsSorted", "Max", "Min", "Replace", "Reverse", "Sort"),
+		sameNames("golang.org/x/exp/maps", "maps", "1.21",
+			"Clone", "Copy", "DeleteFunc", "Equal", "EqualFunc")...,
+	)...,
+)
+
+// sameNames returns the rewrites of names in from to the same names in to.
+func sameNames(from, to, goVersion string, names ...string) []modernization {
+	ms := []modernization{}
+	for _, name := range names {
+		ms = append(ms, modernization{From: from + "." + name, To: to + "." + name, Go: goVersion})
+	}
+	return ms
+}
+
+// cmdModernize rewrites references to deprecated or superseded packages to their standard equivalents,
+// and fixes the imports. Rewrites that need a newer Go than the module's go directive are skipped.
+// Rewrites in .goimps.json are applied before the built-in ones.
+//
+//	$ goimps modernize ./...
+//	a.go
+func cmdModernize(stdout, stderr io.Writer, args []string) int {
+	defer func() {
+		*modernizeDiff = false
+		*modernizeGo = ""
+	}()
+
+	modernizeFlag.Parse(args)
+	if modernizeFlag.NArg() == 0 {
+		fmt.Fprintln(stderr, "usage: goimps modernize [-d] [-go version] paths...")
+		return 2
+	}
+	if _, _, ok := parseGoVersion(*modernizeGo); *modernizeGo != "" && !ok {
+		fmt.Fprintf(stderr, "invalid go version %q\n", *modernizeGo)
+		return 2
+	}
+
+	return rewriteFiles(stdout, stderr, modernizeFlag.Args(), *modernizeDiff, func(filename string, src []byte) ([]byte, error) {
+		cfg, err := loadConfig(configDir(filename))
+		if err != nil {
+			return nil, err
+		}
+
+		goVersion := *modernizeGo
+		if goVersion == "" {
+			goVersion = targetGoVersion(filepath.Dir(filename))
+		}
+
+		return modernizeFile(filename, src, append(cfg.Modernize.Rewrites, builtinModernizations...), goVersion)
+	})
+}
+
+// modernizeFile applies the rewrites allowed by goVersion to src.
+// It returns nil if src isn't changed.
+func modernizeFile(filename string, src []byte, rewrites []modernization, goVersion string) ([]byte, error) {
+	changed := false
+	done := map[string]bool{}
+	for _, m := range rewrites {
+		// a rewrite without the go version is always allowed
+		if done[m.From] || (m.Go != "" && !goVersionAtLeast(goVersion, m.Go)) {
+			continue
+		}
+		// a rewrite in .goimps.json overrides the built-in one
+		done[m.From] = true
+
+		oldPath, oldSym, _ := splitQualifiedSymbol(m.From)
+		newPath, newSym, _ := splitQualifiedSymbol(m.To)
+		if !bytes.Contains(src, []byte(strconv.Quote(oldPath))) {
+			continue
+		}
+
+		res, err := moveSymbol(filename, src, oldPath, oldSym, newPath, newSym)
+		if err != nil {
+			return nil, err
+		}
+		if res != nil {
+			src = res
+			changed = true
+		}
+	}
+	if !changed {
+		return nil, nil
+	}
+
+	return src, nil
+}
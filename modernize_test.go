@@ -0,0 +1,142 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+func TestGoVersionAtLeast(t *testing.T) {
+	tests := []struct {
+		v        string
+		min      string
+		expected bool
+	}{
+		{v: "1.16", min: "1.16", expected: true},
+		{v: "1.21.3", min: "1.16", expected: true},
+		{v: "go1.22rc1", min: "1.22", expected: true},
+		{v: "1.9", min: "1.16", expected: false},
+		{v: "2.0", min: "1.21", expected: true},
+		{v: "", min: "1.21", expected: true},
+	}
+
+	for _, test := range tests {
+		if got := goVersionAtLeast(test.v, test.min); got != test.expected {
+			t.Errorf("expected %t for %s >= %s, but got %t", test.expected, test.v, test.min, got)
+		}
+	}
+}
+
+func TestCmdModernize(t *testing.T) {
+	src, err := ioutil.ReadFile(filepath.Join("testdata", "modernize", "modernize.go"))
+	if err != nil {
+		panic(err)
+	}
+
+	tests := []struct {
+		args     []string
+		expected string
+	}{
+		{
+			// go 1.16 in go.mod
+			expected: `package modernize
+
+import (
+	"io"
+	"os"
+
+	"golang.org/x/exp/slices"
+)
+
+func f(name string) ([]byte, error) {
+	b, err := os.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	if slices.Contains(os.Args, name) {
+		r := io.NopCloser(os.Stdin)
+		return io.ReadAll(r)
+	}
+	return b, os.WriteFile(name, b, 0644)
+}
+`,
+		},
+		{
+			args: []string{"-go", "1.21"},
+			expected: `package modernize
+
+import (
+	"io"
+	"os"
+	"slices"
+)
+
+func f(name string) ([]byte, error) {
+	b, err := os.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	if slices.Contains(os.Args, name) {
+		r := io.NopCloser(os.Stdin)
+		return io.ReadAll(r)
+	}
+	return b, os.WriteFile(name, b, 0644)
+}
+`,
+		},
+		{
+			args:     []string{"-go", "1.15"},
+			expected: string(src),
+		},
+	}
+
+	for _, test := range tests {
+		func() {
+			tmp, cleanup := writeTestTree(t, map[string]string{
+				"go.mod":       "module example.com/m\n\ngo 1.16\n",
+				"modernize.go": string(src),
+			})
+			defer cleanup()
+
+			filename := filepath.Join(tmp, "modernize.go")
+
+			stderr := &bytes.Buffer{}
+			if cmdModernize(&bytes.Buffer{}, stderr, append(test.args, tmp+"/...")) != 0 {
+				t.Errorf("goimps modernize %v should not fail: %s", test.args, stderr.String())
+			}
+
+			b, err := ioutil.ReadFile(filename)
+			if err != nil {
+				panic(err)
+			}
+			if got := string(b); got != test.expected {
+				t.Errorf("goimps modernize %v should rewrite references allowed by the go version\n---expected--- \n`%s`\n--- got --- \n`%s`", test.args, test.expected, got)
+			}
+		}()
+	}
+}
+
+func TestModernizeFileWithoutGoVersion(t *testing.T) {
+	src := `package a
+
+import "github.com/pkg/errors"
+
+var _ = errors.Is(nil, nil)
+`
+	expected := `package a
+
+import "errors"
+
+var _ = errors.Is(nil, nil)
+`
+
+	rewrites := []modernization{{From: "github.com/pkg/errors.Is", To: "errors.Is"}}
+	got, err := modernizeFile("a.go", []byte(src), rewrites, "1.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != expected {
+		t.Errorf("a rewrite without the go version should be always applied\n---expected--- \n`%s`\n--- got --- \n`%s`", expected, got)
+	}
+}
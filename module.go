@@ -0,0 +1,172 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"go/build"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// goMod is a part of go.mod that goimps cares about.
+type goMod struct {
+	dir       string // directory that has go.mod
+	path      string // module path
+	goVersion string // go directive
+
+	// deprecated is the "Deprecated:" paragraph of the comment before or after the module directive.
+	deprecated string
+}
+
+// findGoMod reads the nearest go.mod in dir or its parents.
+// It returns nil if no go.mod is found.
+func findGoMod(dir string) *goMod {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil
+	}
+
+	for {
+		if m, err := readGoMod(filepath.Join(dir, "go.mod")); err == nil {
+			m.dir = dir
+			return m
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return nil
+		}
+		dir = parent
+	}
+}
+
+func readGoMod(filename string) (*goMod, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	m := &goMod{}
+	comment := []string{}
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if strings.HasPrefix(line, "//") {
+			comment = append(comment, line)
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) >= 2 {
+			switch fields[0] {
+			case "module":
+				m.path = unquote(fields[1])
+				// module example.com/old // Deprecated: use example.com/new.
+				if i := strings.Index(line, "//"); i >= 0 {
+					comment = append(comment, line[i:])
+				}
+				m.deprecated = deprecationOf(comment)
+			case "go":
+				m.goVersion = fields[1]
+			}
+		}
+		comment = comment[:0]
+	}
+
+	return m, s.Err()
+}
+
+// containsPath reports whether the import path p belongs to the module.
+func (m *goMod) containsPath(p string) bool {
+	return m != nil && m.path != "" && (p == m.path || strings.HasPrefix(p, m.path+"/"))
+}
+
+// dirImportPath returns the import path of the package in dir,
+// determined by go.mod or GOPATH.
+func dirImportPath(dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+
+	if m := findGoMod(dir); m != nil && m.path != "" {
+		rel, err := filepath.Rel(m.dir, dir)
+		if err != nil {
+			return "", err
+		}
+		if rel == "." {
+			return m.path, nil
+		}
+		return m.path + "/" + filepath.ToSlash(rel), nil
+	}
+
+	for _, srcDir := range getSrcDirs() {
+		srcDir, err := filepath.Abs(srcDir)
+		if err != nil {
+			continue
+		}
+		if rel, err := filepath.Rel(srcDir, dir); err == nil && rel != "." && !strings.HasPrefix(rel, "..") {
+			return filepath.ToSlash(rel), nil
+		}
+	}
+
+	return "", fmt.Errorf("%s is neither in a module nor in GOPATH", dir)
+}
+
+// targetGoVersion returns the go directive of the module of dir,
+// or the version of the Go toolchain if dir isn't in a module.
+func targetGoVersion(dir string) string {
+	if m := findGoMod(dir); m != nil && m.goVersion != "" {
+		return m.goVersion
+	}
+
+	tags := build.Default.ReleaseTags
+	if len(tags) == 0 {
+		return ""
+	}
+	return strings.TrimPrefix(tags[len(tags)-1], "go")
+}
+
+// parseGoVersion parses the major and minor versions of a Go version like 1.21, 1.21.3, 1.22rc1 or go1.21.
+func parseGoVersion(v string) (major, minor int, ok bool) {
+	v = strings.TrimPrefix(v, "go")
+	elems := strings.SplitN(v, ".", 3)
+	if len(elems) < 2 {
+		return 0, 0, false
+	}
+
+	major, err := strconv.Atoi(elems[0])
+	if err != nil {
+		return 0, 0, false
+	}
+
+	// 1.22rc1 -> 22
+	m := elems[1]
+	if i := strings.IndexFunc(m, func(r rune) bool { return r < '0' || r > '9' }); i >= 0 {
+		m = m[:i]
+	}
+	minor, err = strconv.Atoi(m)
+	if err != nil {
+		return 0, 0, false
+	}
+
+	return major, minor, true
+}
+
+// goVersionAtLeast reports whether the Go version v is min or later.
+// An unknown version v allows any min.
+func goVersionAtLeast(v, min string) bool {
+	major, minor, ok := parseGoVersion(v)
+	if !ok {
+		return true
+	}
+	minMajor, minMinor, ok := parseGoVersion(min)
+	if !ok {
+		return false
+	}
+
+	return major > minMajor || (major == minMajor && minor >= minMinor)
+}
@@ -0,0 +1,205 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"strconv"
+	"strings"
+)
+
+var (
+	mvsymFlag = flag.NewFlagSet("goimps mvsym flags", 2)
+	mvsymDiff = mvsymFlag.Bool("d", false, "display diffs instead of rewriting files")
+)
+
+// cmdMvsym rewrites qualified references to a symbol of a package to a symbol of another package.
+// The new import is added where it's needed, and the old one is dropped once it becomes unused.
+//
+//	$ goimps mvsym github.com/org/util.Retry github.com/org/retry.Do ./...
+//	a.go
+func cmdMvsym(stdout, stderr io.Writer, args []string) int {
+	defer func() {
+		*mvsymDiff = false
+	}()
+
+	mvsymFlag.Parse(args)
+	if mvsymFlag.NArg() < 3 {
+		fmt.Fprintln(stderr, "usage: goimps mvsym [-d] oldpath.Name newpath.Name paths...")
+		return 2
+	}
+
+	oldPath, oldSym, ok := splitQualifiedSymbol(mvsymFlag.Arg(0))
+	if !ok {
+		fmt.Fprintf(stderr, "%q is not a qualified exported name like path/to/pkg.Name\n", mvsymFlag.Arg(0))
+		return 2
+	}
+	newPath, newSym, ok := splitQualifiedSymbol(mvsymFlag.Arg(1))
+	if !ok {
+		fmt.Fprintf(stderr, "%q is not a qualified exported name like path/to/pkg.Name\n", mvsymFlag.Arg(1))
+		return 2
+	}
+
+	return rewriteFiles(stdout, stderr, mvsymFlag.Args()[2:], *mvsymDiff, func(filename string, src []byte) ([]byte, error) {
+		return moveSymbol(filename, src, oldPath, oldSym, newPath, newSym)
+	})
+}
+
+// splitQualifiedSymbol splits path/to/pkg.Name into the import path and the name.
+func splitQualifiedSymbol(s string) (string, string, bool) {
+	i := strings.LastIndex(s, ".")
+	if i < 0 || i < strings.LastIndex(s, "/") {
+		return "", "", false
+	}
+
+	p, name := s[:i], s[i+1:]
+	return p, name, p != "" && token.IsIdentifier(name) && ast.IsExported(name)
+}
+
+// moveSymbol rewrites oldName.oldSym in src to newName.newSym, where oldName and newName are
+// the names of the imports of oldPath and newPath. It returns nil if src doesn't refer to oldSym.
+func moveSymbol(filename string, src []byte, oldPath, oldSym, newPath, newSym string) ([]byte, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	imps := analyzeFile(fset, f)
+	old := -1
+	newName := ""
+	for n, i := range imps {
+		if i.isIgnored() {
+			continue
+		}
+		if i.path == oldPath && old < 0 {
+			old = n
+		}
+		if i.path == newPath && newName == "" {
+			newName = i.name
+		}
+	}
+	if old < 0 {
+		return nil, nil
+	}
+	oldName := imps[old].name
+
+	sels := []*ast.SelectorExpr{}
+	ast.Inspect(f, func(n ast.Node) bool {
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		if xid, ok := sel.X.(*ast.Ident); ok && xid.Obj == nil && xid.Name == oldName && sel.Sel.Name == oldSym {
+			sels = append(sels, sel)
+		}
+		return true
+	})
+	if len(sels) == 0 {
+		return nil, nil
+	}
+
+	// the old import is unused if all of its references are moved
+	unused := len(imps[old].refs) == len(sels)
+
+	// the existing import of newPath can't be used where a local name shadows it
+	for _, sel := range sels {
+		if newName != "" && isShadowedAt(f, newName, sel.Pos()) {
+			newName = ""
+		}
+	}
+
+	switch {
+	case newName != "" && unused:
+		deleteImportSpecFromFile(f, f.Imports[old])
+	case newName == "":
+		others := imps
+		if unused {
+			// the name of the old import is available
+			others = append(imps[:old:old], imps[old+1:]...)
+		}
+		// another import of newPath is added if the existing one is shadowed
+		alias, err := chooseImportName(f, filename, others, newPath, "")
+		if err != nil {
+			return nil, err
+		}
+
+		if unused && isStdImportPath(oldPath) == isStdImportPath(newPath) {
+			// replace the old import in place to keep its position
+			spec := f.Imports[old]
+			spec.Path.Value = strconv.Quote(newPath)
+			spec.Name = nil
+			if alias != "" {
+				spec.Name = ast.NewIdent(alias)
+				spec.Name.NamePos = spec.Path.ValuePos
+			}
+		} else {
+			if unused {
+				// the new import belongs to another group
+				deleteImportSpecFromFile(f, f.Imports[old])
+			}
+			addImportSpec(fset, f, alias, newPath)
+		}
+
+		newName = alias
+		if newName == "" {
+			newName, _, _ = resolvePackageName(newPath)
+		}
+	}
+
+	for _, sel := range sels {
+		sel.X.(*ast.Ident).Name = newName
+		sel.Sel.Name = newSym
+	}
+
+	return printFixedFileWithConfig(filename, fset, f, src)
+}
+
+// isShadowedAt reports whether name at pos refers to a local declaration in f
+// instead of a package level name or an import.
+func isShadowedAt(f *ast.File, name string, pos token.Pos) bool {
+	shadowed := false
+	ast.Inspect(f, func(n ast.Node) bool {
+		if shadowed {
+			return false
+		}
+
+		id, ok := n.(*ast.Ident)
+		if !ok || id.Name != name || id.Obj == nil || id.Obj.Kind == ast.Lbl || f.Scope.Lookup(name) == id.Obj {
+			return true
+		}
+		// only declarations, not references
+		if id.Obj.Pos() != id.Pos() || id.Pos() >= pos {
+			return true
+		}
+
+		scope := localScopeOf(f, id.Pos())
+		shadowed = scope != nil && pos < scope.End()
+		return true
+	})
+
+	return shadowed
+}
+
+// localScopeOf returns the innermost node in f that makes the scope of a name declared at pos, or nil.
+func localScopeOf(f *ast.File, pos token.Pos) ast.Node {
+	var scope ast.Node
+	ast.Inspect(f, func(n ast.Node) bool {
+		if n == nil || pos < n.Pos() || n.End() <= pos {
+			return n == nil
+		}
+
+		switch n.(type) {
+		case *ast.FuncDecl, *ast.FuncLit, *ast.BlockStmt, *ast.IfStmt, *ast.ForStmt, *ast.RangeStmt,
+			*ast.SwitchStmt, *ast.TypeSwitchStmt, *ast.SelectStmt, *ast.CaseClause, *ast.CommClause,
+			*ast.StructType, *ast.InterfaceType:
+			scope = n
+		}
+		return true
+	})
+
+	return scope
+}
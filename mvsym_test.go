@@ -0,0 +1,187 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestSplitQualifiedSymbol(t *testing.T) {
+	tests := []struct {
+		in   string
+		path string
+		name string
+		ok   bool
+	}{
+		{in: "github.com/org/util.Retry", path: "github.com/org/util", name: "Retry", ok: true},
+		{in: "gopkg.in/yaml.v3.Marshal", path: "gopkg.in/yaml.v3", name: "Marshal", ok: true},
+		{in: "strings.Fields", path: "strings", name: "Fields", ok: true},
+		{in: "strings.fields", path: "strings", name: "fields", ok: false},
+		{in: "gopkg.in/yaml.v3", ok: false},
+		{in: "Retry", ok: false},
+	}
+
+	for _, test := range tests {
+		p, name, ok := splitQualifiedSymbol(test.in)
+		if ok != test.ok || (ok && (p != test.path || name != test.name)) {
+			t.Errorf("expected %q, %q, %t for %s, but got %q, %q, %t", test.path, test.name, test.ok, test.in, p, name, ok)
+		}
+	}
+}
+
+func TestMoveSymbol(t *testing.T) {
+	tests := []struct {
+		in       string
+		expected string
+	}{
+		// the old import becomes unused
+		{
+			in: `package main
+
+import (
+	"fmt"
+
+	"github.com/org/util"
+)
+
+func main() {
+	fmt.Println(util.Retry(1), util.Retry)
+}
+`,
+			expected: `package main
+
+import (
+	"fmt"
+
+	"github.com/org/retry"
+)
+
+func main() {
+	fmt.Println(retry.Do(1), retry.Do)
+}
+`,
+		},
+		// the old import is still used, and the new import exists with an alias
+		{
+			in: `package main
+
+import (
+	r "github.com/org/retry"
+	"github.com/org/util"
+)
+
+var _ = util.Retry(1) + util.Other + r.X
+`,
+			expected: `package main
+
+import (
+	r "github.com/org/retry"
+	"github.com/org/util"
+)
+
+var _ = r.Do(1) + util.Other + r.X
+`,
+		},
+		// the new package name collides with a package level identifier
+		{
+			in: `package main
+
+import "github.com/org/util"
+
+var retry = util.Retry
+`,
+			expected: `package main
+
+import orgretry "github.com/org/retry"
+
+var retry = orgretry.Do
+`,
+		},
+		// the existing import of the new package is shadowed where a reference is rewritten
+		{
+			in: `package main
+
+import (
+	"github.com/org/retry"
+	"github.com/org/util"
+)
+
+var _ = retry.X
+
+func f() {
+	retry := 1
+	_ = util.Retry(retry)
+}
+`,
+			expected: `package main
+
+import (
+	"github.com/org/retry"
+	orgretry "github.com/org/retry"
+)
+
+var _ = retry.X
+
+func f() {
+	retry := 1
+	_ = orgretry.Do(retry)
+}
+`,
+		},
+		// local names in other scopes don't shadow the existing import
+		{
+			in: `package main
+
+import (
+	"github.com/org/retry"
+	"github.com/org/util"
+)
+
+var _ = retry.X
+
+func f(retry int) {
+	_ = retry
+}
+
+func g() {
+	_ = util.Retry
+	if retry := 1; retry > 0 {
+	}
+}
+`,
+			expected: `package main
+
+import (
+	"github.com/org/retry"
+)
+
+var _ = retry.X
+
+func f(retry int) {
+	_ = retry
+}
+
+func g() {
+	_ = retry.Do
+	if retry := 1; retry > 0 {
+	}
+}
+`,
+		},
+	}
+
+	for _, test := range tests {
+		got, err := moveSymbol("<standard input>", []byte(test.in), "github.com/org/util", "Retry", "github.com/org/retry", "Do")
+		if err != nil {
+			t.Errorf("moveSymbol should not fail: %s", err)
+			continue
+		}
+		if string(got) != test.expected {
+			t.Errorf("moveSymbol should rewrite references\n---expected--- \n`%s`\n--- got --- \n`%s`", test.expected, got)
+		}
+	}
+
+	// no references
+	got, err := moveSymbol("<standard input>", []byte("package main\n\nimport \"github.com/org/util\"\n\nvar _ = util.Other\n"), "github.com/org/util", "Retry", "github.com/org/retry", "Do")
+	if err != nil || got != nil {
+		t.Errorf("moveSymbol should not rewrite a file without references, but got %q, %v", got, err)
+	}
+}
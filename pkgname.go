@@ -0,0 +1,204 @@
+package main
+
+import (
+	"go/build"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var (
+	majorVersionRe = regexp.MustCompile(`^v[0-9]+$`)
+	gopkgInRe      = regexp.MustCompile(`\.v[0-9]+(-unstable)?$`)
+)
+
+var getModCacheDirs = func() []string {
+	if d := os.Getenv("GOMODCACHE"); d != "" {
+		return []string{d}
+	}
+
+	dirs := []string{}
+	for _, p := range filepath.SplitList(build.Default.GOPATH) {
+		dirs = append(dirs, filepath.Join(p, "pkg", "mod"))
+	}
+	return dirs
+}
+
+// findModCacheDir returns the directory of the package p in the module cache.
+// If several versions of the module are cached, the latest one is used.
+// Releases are preferred to pre-releases as the go command does for the latest version.
+func findModCacheDir(p string) string {
+	for _, modCache := range getModCacheDirs() {
+		// try longest module path first
+		// github.com/foo/bar/baz -> github.com/foo/bar/baz@*, github.com/foo/bar@*/baz, ...
+		elems := strings.Split(p, "/")
+		for n := len(elems); n > 0; n-- {
+			mod := strings.Join(elems[:n], "/")
+			matches, err := filepath.Glob(filepath.Join(modCache, filepath.FromSlash(escapeModulePath(mod))) + "@*")
+			if err != nil || len(matches) == 0 {
+				continue
+			}
+			sort.Slice(matches, func(i, j int) bool {
+				return isLaterModuleVersion(moduleVersionOf(matches[j]), moduleVersionOf(matches[i]))
+			})
+
+			dir := filepath.Join(append([]string{matches[len(matches)-1]}, elems[n:]...)...)
+			if fi, err := os.Stat(dir); err == nil && fi.IsDir() {
+				return dir
+			}
+		}
+	}
+
+	return ""
+}
+
+// moduleVersionOf returns the version of the module in dir of the module cache.
+// $GOMODCACHE/github.com/foo/bar@v1.2.3 -> v1.2.3
+func moduleVersionOf(dir string) string {
+	base := filepath.Base(dir)
+	return base[strings.LastIndex(base, "@")+1:]
+}
+
+// isLaterModuleVersion reports whether the module version v is later than w.
+// Releases are later than pre-releases (including pseudo-versions), and the others are compared as semver.
+func isLaterModuleVersion(v, w string) bool {
+	vcore, vpre := splitSemver(v)
+	wcore, wpre := splitSemver(w)
+	if (vpre == "") != (wpre == "") {
+		return vpre == ""
+	}
+
+	if c := compareSemverIdents(strings.Split(vcore, "."), strings.Split(wcore, ".")); c != 0 {
+		return c > 0
+	}
+	if vpre == "" {
+		return false
+	}
+	return compareSemverIdents(strings.Split(vpre, "."), strings.Split(wpre, ".")) > 0
+}
+
+// splitSemver splits the version v into the core version and the pre-release.
+// Build metadata is dropped.
+//
+//	v1.2.3-rc.1+incompatible -> 1.2.3, rc.1
+func splitSemver(v string) (core, pre string) {
+	v = strings.TrimPrefix(v, "v")
+	if i := strings.Index(v, "+"); i >= 0 {
+		v = v[:i]
+	}
+	if i := strings.Index(v, "-"); i >= 0 {
+		return v[:i], v[i+1:]
+	}
+	return v, ""
+}
+
+// compareSemverIdents compares dot-separated identifiers of versions, and returns -1, 0 or +1.
+// Numeric identifiers are compared numerically and are earlier than the others,
+// which are compared lexically. A prefix is earlier than the longer one.
+func compareSemverIdents(a, b []string) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		an, aerr := strconv.Atoi(a[i])
+		bn, berr := strconv.Atoi(b[i])
+		switch {
+		case aerr == nil && berr == nil:
+			if an != bn {
+				if an < bn {
+					return -1
+				}
+				return 1
+			}
+		case aerr == nil:
+			return -1
+		case berr == nil:
+			return 1
+		case a[i] != b[i]:
+			if a[i] < b[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+
+	switch {
+	case len(a) < len(b):
+		return -1
+	case len(a) > len(b):
+		return 1
+	}
+	return 0
+}
+
+// escapeModulePath escapes upper case letters as the module cache does.
+// github.com/Sirupsen/logrus -> github.com/!sirupsen/logrus
+func escapeModulePath(p string) string {
+	var b strings.Builder
+	for _, r := range p {
+		if unicode.IsUpper(r) {
+			b.WriteByte('!')
+			b.WriteRune(unicode.ToLower(r))
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// unescapeModulePath is the reverse of escapeModulePath.
+// github.com/!sirupsen/logrus -> github.com/Sirupsen/logrus
+func unescapeModulePath(p string) string {
+	var b strings.Builder
+	upper := false
+	for _, r := range p {
+		switch {
+		case r == '!':
+			upper = true
+		case upper:
+			b.WriteRune(unicode.ToUpper(r))
+			upper = false
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// inferPackageName guesses the package name from the import path p
+// by the conventions that are commonly used for naming repositories.
+//
+//	github.com/foo/bar/v2     -> bar
+//	gopkg.in/yaml.v3          -> yaml
+//	github.com/go-redis/redis -> redis
+//	github.com/foo/go-bar     -> bar
+//	github.com/foo/bar-go     -> bar
+func inferPackageName(p string) string {
+	elems := strings.Split(p, "/")
+
+	name := elems[len(elems)-1]
+	if majorVersionRe.MatchString(name) && len(elems) > 1 {
+		name = elems[len(elems)-2]
+	}
+
+	name = gopkgInRe.ReplaceAllString(name, "")
+	name = strings.TrimPrefix(name, "go-")
+	name = strings.TrimSuffix(name, "-go")
+	name = strings.TrimSuffix(name, ".go")
+
+	// take leading identifier
+	for i, r := range name {
+		if !(unicode.IsLetter(r) || r == '_' || (i > 0 && unicode.IsDigit(r))) {
+			name = name[:i]
+			break
+		}
+	}
+
+	if name == "" {
+		return path.Base(p)
+	}
+
+	return strings.ToLower(name)
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"go/ast"
+	"go/build"
+	"go/token"
+	"path/filepath"
+	"testing"
+)
+
+func TestInferPackageName(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected string
+	}{
+		{path: "fmt", expected: "fmt"},
+		{path: "net/http", expected: "http"},
+		{path: "github.com/foo/bar/v2", expected: "bar"},
+		{path: "gopkg.in/yaml.v3", expected: "yaml"},
+		{path: "gopkg.in/check.v1", expected: "check"},
+		{path: "github.com/go-redis/redis", expected: "redis"},
+		{path: "github.com/foo/go-bar", expected: "bar"},
+		{path: "github.com/foo/bar-go", expected: "bar"},
+		{path: "github.com/foo/bar.go", expected: "bar"},
+		{path: "github.com/foo/Bar", expected: "bar"},
+	}
+
+	for _, test := range tests {
+		got := inferPackageName(test.path)
+		if got != test.expected {
+			t.Errorf("expected inferred name of %s is %s, but got %s", test.path, test.expected, got)
+		}
+	}
+}
+
+func TestFindModCacheDir(t *testing.T) {
+	orig := getModCacheDirs
+	defer func() {
+		getModCacheDirs = orig
+	}()
+	getModCacheDirs = func() []string {
+		return []string{filepath.Join("testdata", "modcache")}
+	}
+
+	tests := []struct {
+		path    string
+		dir     string
+		pkgname string
+	}{
+		{path: "github.com/Goimps-test/bar/v2", dir: "github.com/!goimps-test/bar/v2@v2.0.0", pkgname: "bar"},
+		{path: "github.com/Goimps-test/bar/v2/sub", dir: "github.com/!goimps-test/bar/v2@v2.0.0/sub", pkgname: "subpkg"},
+		{path: "gopkg.in/yaml.v3", dir: "gopkg.in/yaml.v3@v3.0.0", pkgname: "yaml"},
+		{path: "gopkg.in/notfound.v1", dir: "", pkgname: ""},
+		// the latest release, not the last one in lexical order
+		{path: "github.com/Goimps-test/semver", dir: "github.com/!goimps-test/semver@v1.10.0", pkgname: "semver"},
+	}
+
+	for _, test := range tests {
+		expected := ""
+		if test.dir != "" {
+			expected = filepath.Join("testdata", "modcache", filepath.FromSlash(test.dir))
+		}
+
+		got := findModCacheDir(test.path)
+		if got != expected {
+			t.Errorf("expected dir of %s is %s, but got %s", test.path, expected, got)
+			continue
+		}
+		if got == "" {
+			continue
+		}
+
+		pkg, err := build.ImportDir(got, 0)
+		if err != nil {
+			panic(err)
+		}
+		if pkg.Name != test.pkgname {
+			t.Errorf("expected package name of %s is %s, but got %s", test.path, test.pkgname, pkg.Name)
+		}
+	}
+}
+
+func TestResolveImportNameFromModCache(t *testing.T) {
+	orig := getModCacheDirs
+	defer func() {
+		getModCacheDirs = orig
+	}()
+	getModCacheDirs = func() []string {
+		return []string{filepath.Join("testdata", "modcache")}
+	}
+
+	spec := &ast.ImportSpec{Path: &ast.BasicLit{Kind: token.STRING, Value: `"github.com/Goimps-test/bar/v2"`}}
+	name, resolvedBy, _ := resolveImportName(spec)
+	if name != "bar" || resolvedBy != resolvedByModCache {
+		t.Errorf("expected bar (resolved by %s), but got %s (resolved by %s)", resolvedByModCache, name, resolvedBy)
+	}
+}
+
+func TestIsLaterModuleVersion(t *testing.T) {
+	tests := []struct {
+		v, w     string
+		expected bool
+	}{
+		{v: "v2.10.0", w: "v2.9.0", expected: true},
+		{v: "v2.9.0", w: "v2.10.0", expected: false},
+		{v: "v1.0.0", w: "v1.0.0", expected: false},
+		{v: "v1.0.1", w: "v1.0.0+incompatible", expected: true},
+		{v: "v1.0.0", w: "v1.1.0-rc.1", expected: true},
+		{v: "v1.1.0-rc.10", w: "v1.1.0-rc.9", expected: true},
+		{v: "v1.1.0-rc.1", w: "v1.1.0-beta", expected: true},
+		{v: "v1.1.0-rc", w: "v1.1.0-rc.1", expected: false},
+		{v: "v0.0.0-20200102000000-abcdef123456", w: "v0.0.0-20200101000000-123456abcdef", expected: true},
+	}
+
+	for _, test := range tests {
+		if got := isLaterModuleVersion(test.v, test.w); got != test.expected {
+			t.Errorf("expected %v for isLaterModuleVersion(%q, %q), but got %v", test.expected, test.v, test.w, got)
+		}
+	}
+}
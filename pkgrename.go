@@ -0,0 +1,160 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/parser"
+	"go/token"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+var (
+	pkgrenameFlag = flag.NewFlagSet("goimps pkgrename flags", 2)
+	pkgrenameDiff = pkgrenameFlag.Bool("d", false, "display diffs instead of rewriting files")
+)
+
+// cmdPkgrename renames the package in dir, and updates the importers in paths
+// (default: the module, or the current directory and its subdirectories).
+// Importers that use the package name get their selectors rewritten, and imports with an alias are kept.
+// If the new name collides in an importer, the old name is kept as an alias and the collision is reported.
+// It returns 1 if any collision is found.
+//
+//	$ goimps pkgrename internal/util helpers
+//	internal/util/util.go
+//	a.go
+func cmdPkgrename(stdout, stderr io.Writer, args []string) int {
+	defer func() {
+		*pkgrenameDiff = false
+	}()
+
+	pkgrenameFlag.Parse(args)
+	if pkgrenameFlag.NArg() < 2 {
+		fmt.Fprintln(stderr, "usage: goimps pkgrename [-d] dir newname [paths...]")
+		return 2
+	}
+	dir, newName := pkgrenameFlag.Arg(0), pkgrenameFlag.Arg(1)
+	if !token.IsIdentifier(newName) || newName == "_" {
+		fmt.Fprintf(stderr, "%q is not a valid package name\n", newName)
+		return 2
+	}
+
+	pkg, err := build.ImportDir(dir, 0)
+	if err != nil {
+		fmt.Fprintln(stderr, err.Error())
+		return 1
+	}
+	oldName := pkg.Name
+
+	p, err := dirImportPath(dir)
+	if err != nil {
+		fmt.Fprintln(stderr, err.Error())
+		return 1
+	}
+
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		fmt.Fprintln(stderr, err.Error())
+		return 1
+	}
+
+	paths := pkgrenameFlag.Args()[2:]
+	if len(paths) == 0 {
+		root := "."
+		if m := findGoMod(absDir); m != nil {
+			if wd, err := os.Getwd(); err == nil {
+				if rel, err := filepath.Rel(wd, m.dir); err == nil {
+					root = rel
+				}
+			}
+		}
+		paths = []string{filepath.ToSlash(root) + "/..."}
+	}
+	// the package itself may be out of paths
+	paths = append([]string{dir}, paths...)
+
+	collisions := 0
+	exitCode := rewriteFiles(stdout, stderr, paths, *pkgrenameDiff, func(filename string, src []byte) ([]byte, error) {
+		inDir := false
+		if abs, err := filepath.Abs(filename); err == nil && filepath.Dir(abs) == absDir {
+			inDir = true
+		}
+
+		res, conflicts, err := renamePackage(filename, src, inDir, p, oldName, newName)
+		for _, c := range conflicts {
+			fmt.Fprintln(stderr, c)
+		}
+		collisions += len(conflicts)
+		return res, err
+	})
+	if exitCode == 0 && collisions > 0 {
+		exitCode = 1
+	}
+
+	return exitCode
+}
+
+// renamePackage rewrites the package clause of src from oldName to newName if inDir is true,
+// and the selectors of imports of p that have no alias.
+// It returns nil if src isn't changed, and the imports that keep oldName because newName collides.
+func renamePackage(filename string, src []byte, inDir bool, p, oldName, newName string) ([]byte, []string, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	changed := false
+	if inDir {
+		name := ""
+		switch f.Name.Name {
+		case oldName:
+			name = newName
+		case oldName + "_test":
+			name = newName + "_test"
+		}
+
+		if name != "" {
+			// renameIdents doesn't rewrite identifiers before imports
+			offset := fset.Position(f.Name.Pos()).Offset
+			src = append(append(append([]byte{}, src[:offset]...), name...), src[offset+len(f.Name.Name):]...)
+			changed = true
+
+			fset = token.NewFileSet()
+			f, err = parser.ParseFile(fset, filename, src, parser.ParseComments)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	imps := analyzeFile(fset, f)
+	conflicts := []string{}
+	for n, i := range imps {
+		if i.path != p || i.isIgnored() || i.alias() != "" {
+			continue
+		}
+		changed = true
+
+		if isNameTaken(f, imps, newName, p) {
+			// keep the name that selectors use
+			spec := f.Imports[n]
+			spec.Name = ast.NewIdent(oldName)
+			spec.Name.NamePos = spec.Path.ValuePos
+			conflicts = append(conflicts, fmt.Sprintf("%s: %s is already used in the file, so import %q is named %s", fset.Position(spec.Pos()), newName, p, oldName))
+			continue
+		}
+
+		renameSelectors(f, oldName, newName)
+		imps[n].name = newName
+	}
+	if !changed {
+		return nil, nil, nil
+	}
+
+	res, err := printFixedFileWithConfig(filename, fset, f, src)
+	return res, conflicts, err
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCmdPkgrename(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "goimps-pkgrename-test")
+	if err != nil {
+		panic(err)
+	}
+	defer os.RemoveAll(tmp)
+
+	if err := ioutil.WriteFile(filepath.Join(tmp, "go.mod"), []byte("module example.com/m\n"), 0644); err != nil {
+		panic(err)
+	}
+	for _, name := range []string{"util/util.go", "util/util_test.go", "a/a.go", "b/b.go", "c/c.go"} {
+		b, err := ioutil.ReadFile(filepath.Join("testdata", "pkgrename", name))
+		if err != nil {
+			panic(err)
+		}
+		if err := os.MkdirAll(filepath.Join(tmp, filepath.Dir(name)), 0755); err != nil {
+			panic(err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(tmp, name), b, 0644); err != nil {
+			panic(err)
+		}
+	}
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	if cmdPkgrename(stdout, stderr, []string{filepath.Join(tmp, "util"), "helpers", tmp + "/..."}) != 1 {
+		t.Errorf("goimps pkgrename should fail for the collision")
+	}
+
+	expectedFiles := filepath.Join(tmp, "util", "util.go") + "\n" +
+		filepath.Join(tmp, "util", "util_test.go") + "\n" +
+		filepath.Join(tmp, "a", "a.go") + "\n" +
+		filepath.Join(tmp, "c", "c.go") + "\n"
+	if got := stdout.String(); got != expectedFiles {
+		t.Errorf("goimps pkgrename should list rewritten files: expected %q, but got %q", expectedFiles, got)
+	}
+	if got, expected := stderr.String(), filepath.Join(tmp, "c", "c.go")+`:3:8: helpers is already used in the file, so import "example.com/m/util" is named util`+"\n"; got != expected {
+		t.Errorf("goimps pkgrename should report the collision: expected %q, but got %q", expected, got)
+	}
+
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{
+			name: "util/util.go",
+			expected: `package helpers
+
+func X() int { return 1 }
+`,
+		},
+		{
+			name: "util/util_test.go",
+			expected: `package helpers_test
+
+import (
+	"testing"
+
+	"example.com/m/util"
+)
+
+func TestX(t *testing.T) {
+	if helpers.X() != 1 {
+		t.Fail()
+	}
+}
+`,
+		},
+		{
+			name: "a/a.go",
+			expected: `package a
+
+import (
+	"fmt"
+
+	"example.com/m/util"
+)
+
+var _ = fmt.Sprint(helpers.X())
+`,
+		},
+		{
+			name: "b/b.go",
+			expected: `package b
+
+import u "example.com/m/util"
+
+var _ = u.X()
+`,
+		},
+		{
+			name: "c/c.go",
+			expected: `package c
+
+import util "example.com/m/util"
+
+func f() int {
+	helpers := util.X()
+	return helpers
+}
+`,
+		},
+	}
+
+	for _, test := range tests {
+		b, err := ioutil.ReadFile(filepath.Join(tmp, test.name))
+		if err != nil {
+			panic(err)
+		}
+		if got := string(b); got != test.expected {
+			t.Errorf("goimps pkgrename should rewrite %s\n---expected--- \n`%s`\n--- got --- \n`%s`", test.name, test.expected, got)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+var rankRules = map[string]bool{
+	"sibling":   true,
+	"stdlib":    true,
+	"module":    true,
+	"frequency": true,
+	"shortest":  true,
+}
+
+// ranker orders candidate import paths for a package name by resolve rules.
+type ranker struct {
+	rules        []string
+	siblingPaths map[string]bool
+	mod          *goMod
+	root         string       // where imports are counted for the frequency rule
+	x            *importIndex // where counts of imports are shared
+}
+
+func newRanker(cfg *config, filename string, siblingPaths map[string]bool, x *importIndex) *ranker {
+	r := &ranker{
+		rules:        cfg.Resolve.Rules,
+		siblingPaths: siblingPaths,
+		mod:          findGoMod(configDir(filename)),
+		root:         cfg.dir,
+		x:            x,
+	}
+	if r.mod != nil {
+		r.root = r.mod.dir
+	}
+
+	return r
+}
+
+// score returns the score of p by the rule. Smaller is preferred.
+func (r *ranker) score(rule, p string) int {
+	switch rule {
+	case "sibling":
+		if r.siblingPaths[p] {
+			return 0
+		}
+	case "stdlib":
+		if isStdImportPath(p) {
+			return 0
+		}
+	case "module":
+		if r.mod.containsPath(p) {
+			return 0
+		}
+	case "frequency":
+		return -r.x.importCounts(r.root)[p]
+	case "shortest":
+		return strings.Count(p, "/")
+	}
+
+	return 1
+}
+
+// rank sorts candidates in preferred order.
+// Candidates that no rule can order are sorted by path.
+// It reports whether the first two candidates can't be ordered by the rules.
+func (r *ranker) rank(candidates []string) ([]string, bool) {
+	scores := map[string][]int{}
+	for _, c := range candidates {
+		for _, rule := range r.rules {
+			scores[c] = append(scores[c], r.score(rule, c))
+		}
+	}
+
+	less := func(a, b string) (bool, bool) {
+		for i := range r.rules {
+			if scores[a][i] != scores[b][i] {
+				return scores[a][i] < scores[b][i], true
+			}
+		}
+		return a < b, false
+	}
+
+	sorted := append([]string{}, candidates...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		l, _ := less(sorted[i], sorted[j])
+		return l
+	})
+
+	if len(sorted) < 2 {
+		return sorted, false
+	}
+	_, ordered := less(sorted[0], sorted[1])
+	return sorted, !ordered
+}
+
+// countImports counts import paths in Go files under root.
+func countImports(root string) map[string]int {
+	counts := map[string]int{}
+	if root == "" {
+		return counts
+	}
+
+	filepath.Walk(root, func(path string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return nil
+		}
+
+		if fi.IsDir() {
+			name := fi.Name()
+			if path != root && (strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_") || name == "testdata" || name == "vendor") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if filepath.Ext(path) != ".go" {
+			return nil
+		}
+
+		f, err := parser.ParseFile(token.NewFileSet(), path, nil, parser.ImportsOnly)
+		if err != nil {
+			return nil
+		}
+		for _, spec := range f.Imports {
+			counts[unquote(spec.Path.Value)]++
+		}
+
+		return nil
+	})
+
+	return counts
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"bytes"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRank(t *testing.T) {
+	tests := []struct {
+		rules      []string
+		candidates []string
+		expected   []string
+		ambiguous  bool
+	}{
+		{
+			rules:      []string{"stdlib"},
+			candidates: []string{"github.com/pkg/errors", "errors"},
+			expected:   []string{"errors", "github.com/pkg/errors"},
+		},
+		{
+			rules:      []string{"stdlib"},
+			candidates: []string{"math/rand", "crypto/rand"},
+			expected:   []string{"crypto/rand", "math/rand"},
+			ambiguous:  true,
+		},
+		{
+			rules:      []string{"sibling", "stdlib"},
+			candidates: []string{"crypto/rand", "math/rand"},
+			expected:   []string{"math/rand", "crypto/rand"},
+		},
+		{
+			rules:      []string{"shortest"},
+			candidates: []string{"github.com/foo/bar/errors", "github.com/pkg/errors"},
+			expected:   []string{"github.com/pkg/errors", "github.com/foo/bar/errors"},
+		},
+		{
+			rules:      []string{"module", "stdlib"},
+			candidates: []string{"errors", "example.com/mod/errors"},
+			expected:   []string{"example.com/mod/errors", "errors"},
+		},
+		{
+			rules:      []string{},
+			candidates: []string{"text/template", "html/template"},
+			expected:   []string{"html/template", "text/template"},
+			ambiguous:  true,
+		},
+	}
+
+	for _, test := range tests {
+		r := &ranker{
+			rules:        test.rules,
+			siblingPaths: map[string]bool{"math/rand": true},
+			mod:          &goMod{path: "example.com/mod"},
+		}
+
+		got, ambiguous := r.rank(test.candidates)
+		if !reflect.DeepEqual(got, test.expected) || ambiguous != test.ambiguous {
+			t.Errorf("expected rank of %v by %v is %v (ambiguous=%t), but got %v (ambiguous=%t)", test.candidates, test.rules, test.expected, test.ambiguous, got, ambiguous)
+		}
+	}
+}
+
+func TestCmdFmtAddMissingWithConfig(t *testing.T) {
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	if cmdFmt(nil, stdout, stderr, []string{"-A", filepath.Join("testdata", "resolve", "resolve.go")}) != 0 {
+		t.Errorf("goimps fmt should not fail: %s", stderr.String())
+	}
+
+	expected := `package resolve
+
+import (
+	"crypto/rand"
+	"errors"
+)
+
+var (
+	_ = rand.Int
+	_ = template.New
+	_ = errors.New
+)
+`
+	if got := stdout.String(); got != expected {
+		t.Errorf("goimps fmt -A should resolve packages by .goimps.json\n---expected--- \n`%s`\n--- got --- \n`%s`", expected, got)
+	}
+
+	diag := "resolve.go: template is ambiguous: html/template, text/template"
+	if !strings.Contains(stderr.String(), diag) {
+		t.Errorf("expected diagnostic `%s`, but got `%s`", diag, stderr.String())
+	}
+}
+
+func TestImportIndexImportCounts(t *testing.T) {
+	root := filepath.Join("testdata", "addmissing")
+	x := &importIndex{}
+
+	if got := x.importCounts(root); got["example.com/rand"] != 1 {
+		t.Errorf("expected 1 import of example.com/rand in %s, but got %v", root, got)
+	}
+
+	// imports are counted only once by root
+	x.imports[root]["example.com/rand"] = 2
+	r := &ranker{rules: []string{"frequency"}, root: root, x: x}
+	if got := r.score("frequency", "example.com/rand"); got != -2 {
+		t.Errorf("counts of imports should be read from the index, but got %d", got)
+	}
+}
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+var (
+	replaceFlag = flag.NewFlagSet("goimps replace flags", 2)
+	replaceDiff = replaceFlag.Bool("d", false, "display diffs instead of rewriting files")
+)
+
+// cmdReplace rewrites imports of the path old and its subpackages to new in the files.
+// If the package name changes, the old name is kept as an alias so that selectors still compile.
+//
+//	$ goimps replace github.com/Org/old/pkg example.com/new/pkg/v2 ./...
+//	a.go
+func cmdReplace(stdout, stderr io.Writer, args []string) int {
+	defer func() {
+		*replaceDiff = false
+	}()
+
+	replaceFlag.Parse(args)
+	if replaceFlag.NArg() < 3 {
+		fmt.Fprintln(stderr, "usage: goimps replace [-d] old new paths...")
+		return 2
+	}
+	old, new := replaceFlag.Arg(0), replaceFlag.Arg(1)
+
+	return rewriteFiles(stdout, stderr, replaceFlag.Args()[2:], *replaceDiff, func(filename string, src []byte) ([]byte, error) {
+		return replaceImports(filename, src, old, new)
+	})
+}
+
+// rewriteFiles rewrites Go files in paths by rewrite, and lists rewritten files.
+// rewrite returns nil if the file isn't changed.
+// If diff is true, the diffs are displayed instead of rewriting files.
+func rewriteFiles(stdout, stderr io.Writer, paths []string, diff bool, rewrite func(filename string, src []byte) ([]byte, error)) int {
+	seen := map[string]bool{}
+	for _, p := range paths {
+		files, err := goFiles(p)
+		if err != nil {
+			fmt.Fprintln(stderr, err.Error())
+			return 1
+		}
+
+		for _, filename := range files {
+			if seen[filepath.Clean(filename)] {
+				continue
+			}
+			seen[filepath.Clean(filename)] = true
+
+			src, err := ioutil.ReadFile(filename)
+			if err != nil {
+				fmt.Fprintln(stderr, err.Error())
+				return 1
+			}
+
+			res, err := rewrite(filename, src)
+			if err != nil {
+				fmt.Fprintln(stderr, err.Error())
+				return 1
+			}
+			if res == nil {
+				continue
+			}
+
+			if diff {
+				d, err := diffBytes(src, res)
+				if err != nil {
+					fmt.Fprintln(stderr, err.Error())
+					return 1
+				}
+				fmt.Fprintf(stdout, "diff %s goimps/%s\n", filename, filename)
+				stdout.Write(d)
+				continue
+			}
+
+			if err := ioutil.WriteFile(filename, res, 0); err != nil {
+				fmt.Fprintln(stderr, err.Error())
+				return 1
+			}
+			fmt.Fprintln(stdout, filename)
+		}
+	}
+
+	return 0
+}
+
+// replaceImports rewrites imports of old and its subpackages in src to new.
+// It returns nil if src doesn't import them.
+func replaceImports(filename string, src []byte, old, new string) ([]byte, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	imps := analyzeFile(fset, f)
+	replaced := false
+	for n, i := range imps {
+		p, ok := replaceImportPath(i.path, old, new)
+		if !ok {
+			continue
+		}
+		replaced = true
+
+		spec := f.Imports[n]
+		spec.Path.Value = strconv.Quote(p)
+		if i.isIgnored() || i.alias() != "" {
+			continue
+		}
+
+		// keep the name that selectors use
+		if name, _, _ := resolvePackageName(p); name != i.name {
+			spec.Name = ast.NewIdent(i.name)
+			spec.Name.NamePos = spec.Path.ValuePos
+		}
+	}
+	if !replaced {
+		return nil, nil
+	}
+
+	return printFixedFileWithConfig(filename, fset, f, src)
+}
+
+// replaceImportPath replaces the prefix old of the import path p with new.
+//
+//	github.com/Org/old/pkg/sub -> example.com/new/pkg/v2/sub
+func replaceImportPath(p, old, new string) (string, bool) {
+	if p == old {
+		return new, true
+	}
+	if strings.HasPrefix(p, old+"/") {
+		return new + strings.TrimPrefix(p, old), true
+	}
+	return p, false
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestReplaceImportPath(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected string
+		ok       bool
+	}{
+		{path: "github.com/Org/old/pkg", expected: "example.com/new/pkg/v2", ok: true},
+		{path: "github.com/Org/old/pkg/sub", expected: "example.com/new/pkg/v2/sub", ok: true},
+		{path: "github.com/Org/old/pkgutil", expected: "github.com/Org/old/pkgutil", ok: false},
+		{path: "github.com/Org/old", expected: "github.com/Org/old", ok: false},
+	}
+
+	for _, test := range tests {
+		got, ok := replaceImportPath(test.path, "github.com/Org/old/pkg", "example.com/new/pkg/v2")
+		if got != test.expected || ok != test.ok {
+			t.Errorf("expected %s (%t) for %s, but got %s (%t)", test.expected, test.ok, test.path, got, ok)
+		}
+	}
+}
+
+func TestCmdReplace(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "goimps-replace-test")
+	if err != nil {
+		panic(err)
+	}
+	defer os.RemoveAll(tmp)
+
+	for _, name := range []string{"replace.go", "sub/sub.go"} {
+		b, err := ioutil.ReadFile(filepath.Join("testdata", "replace", name))
+		if err != nil {
+			panic(err)
+		}
+		if err := os.MkdirAll(filepath.Join(tmp, filepath.Dir(name)), 0755); err != nil {
+			panic(err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(tmp, name), b, 0644); err != nil {
+			panic(err)
+		}
+	}
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	if cmdReplace(stdout, stderr, []string{"github.com/Org/old/pkg", "example.com/new/newpkg/v2", tmp + "/..."}) != 0 {
+		t.Errorf("goimps replace should not fail: %s", stderr.String())
+	}
+	if got, expected := stdout.String(), filepath.Join(tmp, "replace.go")+"\n"; got != expected {
+		t.Errorf("goimps replace should list rewritten files: expected %q, but got %q", expected, got)
+	}
+
+	expected := `package replace
+
+import (
+	"fmt"
+
+	pkg "example.com/new/newpkg/v2"
+	"example.com/new/newpkg/v2/sub"
+	legacy "github.com/Org/old/pkgutil"
+)
+
+var _ = fmt.Sprint(pkg.X, sub.Y, legacy.Z)
+`
+	b, err := ioutil.ReadFile(filepath.Join(tmp, "replace.go"))
+	if err != nil {
+		panic(err)
+	}
+	if got := string(b); got != expected {
+		t.Errorf("goimps replace should rewrite imports\n---expected--- \n`%s`\n--- got --- \n`%s`", expected, got)
+	}
+}
@@ -0,0 +1,35 @@
+package main
+
+//go:generate go run mkstdversions.go
+
+// stdVersionOf returns the Go release that added the standard package p,
+// or its exported symbol name if name isn't "".
+// It returns "" if p isn't a standard package in the catalog.
+func stdVersionOf(p, name string) string {
+	if name != "" {
+		if v, ok := stdSymbolVersions[p+"."+name]; ok {
+			return v
+		}
+	}
+
+	return stdPackageVersions[p]
+}
+
+// isStdAvailable reports whether the standard package p (or its symbol name) is available in goVersion.
+// Packages that aren't in the catalog are always available.
+func isStdAvailable(p, name, goVersion string) bool {
+	v := stdVersionOf(p, name)
+	return v == "" || goVersionAtLeast(goVersion, v)
+}
+
+// filterByGoVersion returns pkgs without standard packages that are newer than goVersion.
+func filterByGoVersion(pkgs []importable, goVersion string) []importable {
+	filtered := []importable{}
+	for _, pkg := range pkgs {
+		if isStdAvailable(pkg.path, "", goVersion) {
+			filtered = append(filtered, pkg)
+		}
+	}
+
+	return filtered
+}
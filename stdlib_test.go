@@ -0,0 +1,44 @@
+package main
+
+import (
+	"go/build"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestStdVersionOf(t *testing.T) {
+	tests := []struct {
+		path     string
+		name     string
+		expected string
+	}{
+		{path: "fmt", expected: "1.0"},
+		{path: "slices", expected: "1.21"},
+		{path: "math/rand/v2", expected: "1.22"},
+		{path: "strings", name: "CutPrefix", expected: "1.20"},
+		{path: "strings", name: "Fields", expected: "1.0"},
+		{path: "github.com/pkg/errors", expected: ""},
+	}
+
+	for _, test := range tests {
+		if got := stdVersionOf(test.path, test.name); got != test.expected {
+			t.Errorf("expected %q for %s %s, but got %q", test.expected, test.path, test.name, got)
+		}
+	}
+}
+
+func TestFindImportCandidatesGoVersion(t *testing.T) {
+	x := &importIndex{pkgs: []importable{{path: "slices", dir: filepath.Join(build.Default.GOROOT, "src", "slices")}}}
+	ref := missingRef{name: "slices", sels: []string{"Contains"}}
+
+	if got := findImportCandidates(ref, x, "1.20"); len(got) != 0 {
+		t.Errorf("slices should not be a candidate for go 1.20, but got %v", got)
+	}
+	if got, expected := findImportCandidates(ref, x, "1.21"), []string{"slices"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v for go 1.21, but got %v", expected, got)
+	}
+	if got := findImportCandidates(missingRef{name: "slices", sels: []string{"Repeat"}}, x, "1.22"); len(got) != 0 {
+		t.Errorf("slices.Repeat (go 1.23) should not be found for go 1.22, but got %v", got)
+	}
+}
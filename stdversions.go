@@ -0,0 +1,3516 @@
+// Code generated by mkstdversions.go; DO NOT EDIT.
+
+package main
+
+// stdPackageVersions maps standard packages to the Go release that added them.
+var stdPackageVersions = map[string]string{
+	"archive/tar":            "1.0",
+	"archive/zip":            "1.0",
+	"bufio":                  "1.0",
+	"bytes":                  "1.0",
+	"cmp":                    "1.21",
+	"compress/bzip2":         "1.0",
+	"compress/flate":         "1.0",
+	"compress/gzip":          "1.0",
+	"compress/lzw":           "1.0",
+	"compress/zlib":          "1.0",
+	"container/heap":         "1.0",
+	"container/list":         "1.0",
+	"container/ring":         "1.0",
+	"context":                "1.7",
+	"crypto":                 "1.0",
+	"crypto/aes":             "1.0",
+	"crypto/cipher":          "1.0",
+	"crypto/des":             "1.0",
+	"crypto/dsa":             "1.0",
+	"crypto/ecdh":            "1.20",
+	"crypto/ecdsa":           "1.0",
+	"crypto/ed25519":         "1.13",
+	"crypto/elliptic":        "1.0",
+	"crypto/fips140":         "1.24",
+	"crypto/hkdf":            "1.24",
+	"crypto/hmac":            "1.0",
+	"crypto/hpke":            "1.26",
+	"crypto/md5":             "1.0",
+	"crypto/mldsa":           "1.27",
+	"crypto/mlkem":           "1.24",
+	"crypto/mlkem/mlkemtest": "1.26",
+	"crypto/pbkdf2":          "1.24",
+	"crypto/rand":            "1.0",
+	"crypto/rc4":             "1.0",
+	"crypto/rsa":             "1.0",
+	"crypto/sha1":            "1.0",
+	"crypto/sha256":          "1.0",
+	"crypto/sha3":            "1.24",
+	"crypto/sha512":          "1.0",
+	"crypto/subtle":          "1.0",
+	"crypto/tls":             "1.0",
+	"crypto/x509":            "1.0",
+	"crypto/x509/pkix":       "1.0",
+	"database/sql":           "1.0",
+	"database/sql/driver":    "1.0",
+	"debug/buildinfo":        "1.18",
+	"debug/dwarf":            "1.0",
+	"debug/elf":              "1.0",
+	"debug/gosym":            "1.0",
+	"debug/macho":            "1.0",
+	"debug/pe":               "1.0",
+	"debug/plan9obj":         "1.3",
+	"embed":                  "1.16",
+	"encoding":               "1.2",
+	"encoding/ascii85":       "1.0",
+	"encoding/asn1":          "1.0",
+	"encoding/base32":        "1.0",
+	"encoding/base64":        "1.0",
+	"encoding/binary":        "1.0",
+	"encoding/csv":           "1.0",
+	"encoding/gob":           "1.0",
+	"encoding/hex":           "1.0",
+	"encoding/json":          "1.0",
+	"encoding/json/jsontext": "1.27",
+	"encoding/json/v2":       "1.27",
+	"encoding/pem":           "1.0",
+	"encoding/xml":           "1.0",
+	"errors":                 "1.0",
+	"expvar":                 "1.0",
+	"flag":                   "1.0",
+	"fmt":                    "1.0",
+	"go/ast":                 "1.0",
+	"go/build":               "1.0",
+	"go/build/constraint":    "1.16",
+	"go/constant":            "1.5",
+	"go/doc":                 "1.0",
+	"go/doc/comment":         "1.19",
+	"go/format":              "1.1",
+	"go/importer":            "1.5",
+	"go/parser":              "1.0",
+	"go/printer":             "1.0",
+	"go/scanner":             "1.0",
+	"go/token":               "1.0",
+	"go/types":               "1.5",
+	"go/version":             "1.22",
+	"hash":                   "1.0",
+	"hash/adler32":           "1.0",
+	"hash/crc32":             "1.0",
+	"hash/crc64":             "1.0",
+	"hash/fnv":               "1.0",
+	"hash/maphash":           "1.14",
+	"html":                   "1.0",
+	"html/template":          "1.0",
+	"image":                  "1.0",
+	"image/color":            "1.0",
+	"image/color/palette":    "1.2",
+	"image/draw":             "1.0",
+	"image/gif":              "1.0",
+	"image/jpeg":             "1.0",
+	"image/png":              "1.0",
+	"index/suffixarray":      "1.0",
+	"io":                     "1.0",
+	"io/fs":                  "1.16",
+	"io/ioutil":              "1.0",
+	"iter":                   "1.23",
+	"log":                    "1.0",
+	"log/slog":               "1.21",
+	"log/syslog":             "1.0",
+	"maps":                   "1.21",
+	"math":                   "1.0",
+	"math/big":               "1.0",
+	"math/bits":              "1.9",
+	"math/cmplx":             "1.0",
+	"math/rand":              "1.0",
+	"math/rand/v2":           "1.22",
+	"mime":                   "1.0",
+	"mime/multipart":         "1.0",
+	"mime/quotedprintable":   "1.5",
+	"net":                    "1.0",
+	"net/http":               "1.0",
+	"net/http/cgi":           "1.0",
+	"net/http/cookiejar":     "1.1",
+	"net/http/fcgi":          "1.0",
+	"net/http/httptest":      "1.0",
+	"net/http/httptrace":     "1.7",
+	"net/http/httputil":      "1.0",
+	"net/http/pprof":         "1.0",
+	"net/mail":               "1.0",
+	"net/netip":              "1.18",
+	"net/rpc":                "1.0",
+	"net/rpc/jsonrpc":        "1.0",
+	"net/smtp":               "1.0",
+	"net/textproto":          "1.0",
+	"net/url":                "1.0",
+	"os":                     "1.0",
+	"os/exec":                "1.0",
+	"os/signal":              "1.0",
+	"os/user":                "1.0",
+	"path":                   "1.0",
+	"path/filepath":          "1.0",
+	"plugin":                 "1.8",
+	"reflect":                "1.0",
+	"regexp":                 "1.0",
+	"regexp/syntax":          "1.0",
+	"runtime":                "1.0",
+	"runtime/cgo":            "1.17",
+	"runtime/coverage":       "1.20",
+	"runtime/debug":          "1.0",
+	"runtime/metrics":        "1.16",
+	"runtime/pprof":          "1.0",
+	"runtime/trace":          "1.5",
+	"slices":                 "1.21",
+	"sort":                   "1.0",
+	"strconv":                "1.0",
+	"strings":                "1.0",
+	"structs":                "1.23",
+	"sync":                   "1.0",
+	"sync/atomic":            "1.0",
+	"syscall":                "1.0",
+	"testing":                "1.0",
+	"testing/cryptotest":     "1.26",
+	"testing/fstest":         "1.16",
+	"testing/iotest":         "1.0",
+	"testing/quick":          "1.0",
+	"testing/slogtest":       "1.21",
+	"testing/synctest":       "1.25",
+	"text/scanner":           "1.0",
+	"text/tabwriter":         "1.0",
+	"text/template":          "1.0",
+	"text/template/parse":    "1.0",
+	"time":                   "1.0",
+	"unicode":                "1.0",
+	"unicode/utf16":          "1.0",
+	"unicode/utf8":           "1.0",
+	"unique":                 "1.23",
+	"uuid":                   "1.27",
+	"weak":                   "1.24",
+}
+
+// stdSymbolVersions maps exported symbols (path.Name) of standard packages
+// to the Go release that added them, if it's later than their package.
+var stdSymbolVersions = map[string]string{
+	"archive/tar.ErrInsecurePath":                              "1.20",
+	"archive/tar.FileInfoHeader":                               "1.1",
+	"archive/tar.FileInfoNames":                                "1.23",
+	"archive/tar.Format":                                       "1.10",
+	"archive/tar.FormatGNU":                                    "1.10",
+	"archive/tar.FormatPAX":                                    "1.10",
+	"archive/tar.FormatUSTAR":                                  "1.10",
+	"archive/tar.FormatUnknown":                                "1.10",
+	"archive/tar.TypeGNULongLink":                              "1.1",
+	"archive/tar.TypeGNULongName":                              "1.1",
+	"archive/tar.TypeGNUSparse":                                "1.3",
+	"archive/zip.Compressor":                                   "1.2",
+	"archive/zip.Decompressor":                                 "1.2",
+	"archive/zip.ErrInsecurePath":                              "1.20",
+	"archive/zip.RegisterCompressor":                           "1.2",
+	"archive/zip.RegisterDecompressor":                         "1.2",
+	"bufio.ErrAdvanceTooFar":                                   "1.1",
+	"bufio.ErrBadReadCount":                                    "1.15",
+	"bufio.ErrFinalToken":                                      "1.6",
+	"bufio.ErrNegativeAdvance":                                 "1.1",
+	"bufio.ErrTooLong":                                         "1.1",
+	"bufio.MaxScanTokenSize":                                   "1.1",
+	"bufio.NewScanner":                                         "1.1",
+	"bufio.ScanBytes":                                          "1.1",
+	"bufio.ScanLines":                                          "1.1",
+	"bufio.ScanRunes":                                          "1.1",
+	"bufio.ScanWords":                                          "1.1",
+	"bufio.Scanner":                                            "1.1",
+	"bufio.SplitFunc":                                          "1.1",
+	"bytes.Clone":                                              "1.20",
+	"bytes.ContainsAny":                                        "1.7",
+	"bytes.ContainsFunc":                                       "1.21",
+	"bytes.ContainsRune":                                       "1.7",
+	"bytes.Cut":                                                "1.18",
+	"bytes.CutLast":                                            "1.27",
+	"bytes.CutPrefix":                                          "1.20",
+	"bytes.CutSuffix":                                          "1.20",
+	"bytes.FieldsFuncSeq":                                      "1.24",
+	"bytes.FieldsSeq":                                          "1.24",
+	"bytes.LastIndexByte":                                      "1.5",
+	"bytes.Lines":                                              "1.24",
+	"bytes.ReplaceAll":                                         "1.12",
+	"bytes.SplitAfterSeq":                                      "1.24",
+	"bytes.SplitSeq":                                           "1.24",
+	"bytes.ToValidUTF8":                                        "1.13",
+	"bytes.TrimPrefix":                                         "1.1",
+	"bytes.TrimSuffix":                                         "1.1",
+	"cmp.Or":                                                   "1.22",
+	"compress/flate.HuffmanOnly":                               "1.7",
+	"compress/flate.Resetter":                                  "1.4",
+	"compress/gzip.HuffmanOnly":                                "1.8",
+	"compress/lzw.Reader":                                      "1.17",
+	"compress/lzw.Writer":                                      "1.17",
+	"compress/zlib.HuffmanOnly":                                "1.8",
+	"compress/zlib.Resetter":                                   "1.4",
+	"container/heap.Fix":                                       "1.2",
+	"context.AfterFunc":                                        "1.21",
+	"context.CancelCauseFunc":                                  "1.20",
+	"context.Cause":                                            "1.20",
+	"context.WithCancelCause":                                  "1.20",
+	"context.WithDeadlineCause":                                "1.21",
+	"context.WithTimeoutCause":                                 "1.21",
+	"context.WithoutCancel":                                    "1.21",
+	"crypto.BLAKE2b_256":                                       "1.9",
+	"crypto.BLAKE2b_384":                                       "1.9",
+	"crypto.BLAKE2b_512":                                       "1.9",
+	"crypto.BLAKE2s_256":                                       "1.9",
+	"crypto.Decapsulator":                                      "1.26",
+	"crypto.Decrypter":                                         "1.5",
+	"crypto.DecrypterOpts":                                     "1.5",
+	"crypto.Encapsulator":                                      "1.26",
+	"crypto.MLDSAMu":                                           "1.27",
+	"crypto.MessageSigner":                                     "1.25",
+	"crypto.PublicKey":                                         "1.2",
+	"crypto.SHA3_224":                                          "1.4",
+	"crypto.SHA3_256":                                          "1.4",
+	"crypto.SHA3_384":                                          "1.4",
+	"crypto.SHA3_512":                                          "1.4",
+	"crypto.SHA512_224":                                        "1.5",
+	"crypto.SHA512_256":                                        "1.5",
+	"crypto.SignMessage":                                       "1.25",
+	"crypto.Signer":                                            "1.4",
+	"crypto.SignerOpts":                                        "1.4",
+	"crypto/cipher.AEAD":                                       "1.2",
+	"crypto/cipher.NewGCM":                                     "1.2",
+	"crypto/cipher.NewGCMWithNonceSize":                        "1.5",
+	"crypto/cipher.NewGCMWithRandomNonce":                      "1.24",
+	"crypto/cipher.NewGCMWithTagSize":                          "1.11",
+	"crypto/ecdh.KeyExchanger":                                 "1.26",
+	"crypto/ecdsa.ParseRawPrivateKey":                          "1.25",
+	"crypto/ecdsa.ParseUncompressedPublicKey":                  "1.25",
+	"crypto/ecdsa.SignASN1":                                    "1.15",
+	"crypto/ecdsa.VerifyASN1":                                  "1.15",
+	"crypto/ed25519.Options":                                   "1.20",
+	"crypto/ed25519.VerifyWithOptions":                         "1.20",
+	"crypto/elliptic.MarshalCompressed":                        "1.15",
+	"crypto/elliptic.UnmarshalCompressed":                      "1.15",
+	"crypto/fips140.Enforced":                                  "1.26",
+	"crypto/fips140.Version":                                   "1.26",
+	"crypto/fips140.WithoutEnforcement":                        "1.26",
+	"crypto/hmac.Equal":                                        "1.1",
+	"crypto/md5.Sum":                                           "1.2",
+	"crypto/rand.Text":                                         "1.24",
+	"crypto/rsa.EncryptOAEPWithOptions":                        "1.26",
+	"crypto/rsa.OAEPOptions":                                   "1.5",
+	"crypto/rsa.PKCS1v15DecryptOptions":                        "1.5",
+	"crypto/rsa.PSSOptions":                                    "1.2",
+	"crypto/rsa.PSSSaltLengthAuto":                             "1.2",
+	"crypto/rsa.PSSSaltLengthEqualsHash":                       "1.2",
+	"crypto/rsa.SignPSS":                                       "1.2",
+	"crypto/rsa.VerifyPSS":                                     "1.2",
+	"crypto/sha1.Sum":                                          "1.2",
+	"crypto/sha256.Sum224":                                     "1.2",
+	"crypto/sha256.Sum256":                                     "1.2",
+	"crypto/sha512.New512_224":                                 "1.5",
+	"crypto/sha512.New512_256":                                 "1.5",
+	"crypto/sha512.Size224":                                    "1.5",
+	"crypto/sha512.Size256":                                    "1.5",
+	"crypto/sha512.Sum384":                                     "1.2",
+	"crypto/sha512.Sum512":                                     "1.2",
+	"crypto/sha512.Sum512_224":                                 "1.5",
+	"crypto/sha512.Sum512_256":                                 "1.5",
+	"crypto/subtle.ConstantTimeLessOrEq":                       "1.2",
+	"crypto/subtle.WithDataIndependentTiming":                  "1.24",
+	"crypto/subtle.XORBytes":                                   "1.20",
+	"crypto/tls.AlertError":                                    "1.21",
+	"crypto/tls.CertificateRequestInfo":                        "1.8",
+	"crypto/tls.CertificateVerificationError":                  "1.20",
+	"crypto/tls.CipherSuite":                                   "1.14",
+	"crypto/tls.CipherSuiteName":                               "1.14",
+	"crypto/tls.CipherSuites":                                  "1.14",
+	"crypto/tls.ClientHelloInfo":                               "1.4",
+	"crypto/tls.ClientSessionCache":                            "1.3",
+	"crypto/tls.ClientSessionState":                            "1.3",
+	"crypto/tls.CurveID":                                       "1.3",
+	"crypto/tls.CurveP256":                                     "1.3",
+	"crypto/tls.CurveP384":                                     "1.3",
+	"crypto/tls.CurveP521":                                     "1.3",
+	"crypto/tls.DialWithDialer":                                "1.3",
+	"crypto/tls.Dialer":                                        "1.15",
+	"crypto/tls.ECDSAWithP256AndSHA256":                        "1.8",
+	"crypto/tls.ECDSAWithP384AndSHA384":                        "1.8",
+	"crypto/tls.ECDSAWithP521AndSHA512":                        "1.8",
+	"crypto/tls.ECDSAWithSHA1":                                 "1.10",
+	"crypto/tls.ECHRejectionError":                             "1.23",
+	"crypto/tls.Ed25519":                                       "1.13",
+	"crypto/tls.EncryptedClientHelloKey":                       "1.24",
+	"crypto/tls.InsecureCipherSuites":                          "1.14",
+	"crypto/tls.MLDSA44":                                       "1.27",
+	"crypto/tls.MLDSA65":                                       "1.27",
+	"crypto/tls.MLDSA87":                                       "1.27",
+	"crypto/tls.MLKEM1024":                                     "1.27",
+	"crypto/tls.NewLRUClientSessionCache":                      "1.3",
+	"crypto/tls.NewResumptionState":                            "1.21",
+	"crypto/tls.PKCS1WithSHA1":                                 "1.8",
+	"crypto/tls.PKCS1WithSHA256":                               "1.8",
+	"crypto/tls.PKCS1WithSHA384":                               "1.8",
+	"crypto/tls.PKCS1WithSHA512":                               "1.8",
+	"crypto/tls.PSSWithSHA256":                                 "1.8",
+	"crypto/tls.PSSWithSHA384":                                 "1.8",
+	"crypto/tls.PSSWithSHA512":                                 "1.8",
+	"crypto/tls.ParseSessionState":                             "1.21",
+	"crypto/tls.QUICClient":                                    "1.21",
+	"crypto/tls.QUICConfig":                                    "1.21",
+	"crypto/tls.QUICConn":                                      "1.21",
+	"crypto/tls.QUICEncryptionLevel":                           "1.21",
+	"crypto/tls.QUICEncryptionLevelApplication":                "1.21",
+	"crypto/tls.QUICEncryptionLevelEarly":                      "1.21",
+	"crypto/tls.QUICEncryptionLevelHandshake":                  "1.21",
+	"crypto/tls.QUICEncryptionLevelInitial":                    "1.21",
+	"crypto/tls.QUICErrorEvent":                                "1.26",
+	"crypto/tls.QUICEvent":                                     "1.21",
+	"crypto/tls.QUICEventKind":                                 "1.21",
+	"crypto/tls.QUICHandshakeDone":                             "1.21",
+	"crypto/tls.QUICNoEvent":                                   "1.21",
+	"crypto/tls.QUICRejectedEarlyData":                         "1.21",
+	"crypto/tls.QUICResumeSession":                             "1.23",
+	"crypto/tls.QUICServer":                                    "1.21",
+	"crypto/tls.QUICSessionTicketOptions":                      "1.21",
+	"crypto/tls.QUICSetReadSecret":                             "1.21",
+	"crypto/tls.QUICSetWriteSecret":                            "1.21",
+	"crypto/tls.QUICStoreSession":                              "1.23",
+	"crypto/tls.QUICTransportParameters":                       "1.21",
+	"crypto/tls.QUICTransportParametersRequired":               "1.21",
+	"crypto/tls.QUICWriteData":                                 "1.21",
+	"crypto/tls.RecordHeaderError":                             "1.6",
+	"crypto/tls.RenegotiateFreelyAsClient":                     "1.7",
+	"crypto/tls.RenegotiateNever":                              "1.7",
+	"crypto/tls.RenegotiateOnceAsClient":                       "1.7",
+	"crypto/tls.RenegotiationSupport":                          "1.7",
+	"crypto/tls.SecP256r1MLKEM768":                             "1.26",
+	"crypto/tls.SecP384r1MLKEM1024":                            "1.26",
+	"crypto/tls.SessionState":                                  "1.21",
+	"crypto/tls.SignatureScheme":                               "1.8",
+	"crypto/tls.TLS_AES_128_GCM_SHA256":                        "1.12",
+	"crypto/tls.TLS_AES_256_GCM_SHA384":                        "1.12",
+	"crypto/tls.TLS_CHACHA20_POLY1305_SHA256":                  "1.12",
+	"crypto/tls.TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA":          "1.2",
+	"crypto/tls.TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA256":       "1.8",
+	"crypto/tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256":       "1.2",
+	"crypto/tls.TLS_ECDHE_ECDSA_WITH_AES_256_CBC_SHA":          "1.2",
+	"crypto/tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384":       "1.5",
+	"crypto/tls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305":        "1.8",
+	"crypto/tls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256": "1.14",
+	"crypto/tls.TLS_ECDHE_ECDSA_WITH_RC4_128_SHA":              "1.2",
+	"crypto/tls.TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA256":         "1.8",
+	"crypto/tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256":         "1.2",
+	"crypto/tls.TLS_ECDHE_RSA_WITH_AES_256_CBC_SHA":            "1.1",
+	"crypto/tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384":         "1.5",
+	"crypto/tls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305":          "1.8",
+	"crypto/tls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305_SHA256":   "1.14",
+	"crypto/tls.TLS_FALLBACK_SCSV":                             "1.4",
+	"crypto/tls.TLS_RSA_WITH_AES_128_CBC_SHA256":               "1.8",
+	"crypto/tls.TLS_RSA_WITH_AES_128_GCM_SHA256":               "1.6",
+	"crypto/tls.TLS_RSA_WITH_AES_256_CBC_SHA":                  "1.1",
+	"crypto/tls.TLS_RSA_WITH_AES_256_GCM_SHA384":               "1.6",
+	"crypto/tls.VersionName":                                   "1.21",
+	"crypto/tls.VersionSSL30":                                  "1.2",
+	"crypto/tls.VersionTLS10":                                  "1.2",
+	"crypto/tls.VersionTLS11":                                  "1.2",
+	"crypto/tls.VersionTLS12":                                  "1.2",
+	"crypto/tls.VersionTLS13":                                  "1.12",
+	"crypto/tls.X25519":                                        "1.8",
+	"crypto/tls.X25519MLKEM768":                                "1.24",
+	"crypto/x509.CANotAuthorizedForExtKeyUsage":                "1.10",
+	"crypto/x509.CertificateRequest":                           "1.3",
+	"crypto/x509.CreateCertificateRequest":                     "1.3",
+	"crypto/x509.CreateRevocationList":                         "1.15",
+	"crypto/x509.DecryptPEMBlock":                              "1.1",
+	"crypto/x509.ECDSA":                                        "1.1",
+	"crypto/x509.ECDSAWithSHA1":                                "1.1",
+	"crypto/x509.ECDSAWithSHA256":                              "1.1",
+	"crypto/x509.ECDSAWithSHA384":                              "1.1",
+	"crypto/x509.ECDSAWithSHA512":                              "1.1",
+	"crypto/x509.Ed25519":                                      "1.13",
+	"crypto/x509.EncryptPEMBlock":                              "1.1",
+	"crypto/x509.ExtKeyUsageIPSECEndSystem":                    "1.1",
+	"crypto/x509.ExtKeyUsageIPSECTunnel":                       "1.1",
+	"crypto/x509.ExtKeyUsageIPSECUser":                         "1.1",
+	"crypto/x509.ExtKeyUsageMicrosoftCommercialCodeSigning":    "1.10",
+	"crypto/x509.ExtKeyUsageMicrosoftKernelCodeSigning":        "1.10",
+	"crypto/x509.ExtKeyUsageMicrosoftServerGatedCrypto":        "1.1",
+	"crypto/x509.ExtKeyUsageNetscapeServerGatedCrypto":         "1.1",
+	"crypto/x509.IncompatibleUsage":                            "1.1",
+	"crypto/x509.IncorrectPasswordError":                       "1.1",
+	"crypto/x509.InsecureAlgorithmError":                       "1.6",
+	"crypto/x509.IsEncryptedPEMBlock":                          "1.1",
+	"crypto/x509.MLDSA":                                        "1.27",
+	"crypto/x509.MLDSA44":                                      "1.27",
+	"crypto/x509.MLDSA65":                                      "1.27",
+	"crypto/x509.MLDSA87":                                      "1.27",
+	"crypto/x509.MarshalECPrivateKey":                          "1.2",
+	"crypto/x509.MarshalPKCS1PublicKey":                        "1.10",
+	"crypto/x509.MarshalPKCS8PrivateKey":                       "1.10",
+	"crypto/x509.NameConstraintsWithoutSANs":                   "1.10",
+	"crypto/x509.NameMismatch":                                 "1.8",
+	"crypto/x509.NoValidChains":                                "1.24",
+	"crypto/x509.OID":                                          "1.22",
+	"crypto/x509.OIDFromASN1OID":                               "1.26",
+	"crypto/x509.OIDFromInts":                                  "1.22",
+	"crypto/x509.PEMCipher":                                    "1.1",
+	"crypto/x509.PEMCipher3DES":                                "1.1",
+	"crypto/x509.PEMCipherAES128":                              "1.1",
+	"crypto/x509.PEMCipherAES192":                              "1.1",
+	"crypto/x509.PEMCipherAES256":                              "1.1",
+	"crypto/x509.PEMCipherDES":                                 "1.1",
+	"crypto/x509.ParseCertificateRequest":                      "1.3",
+	"crypto/x509.ParseECPrivateKey":                            "1.1",
+	"crypto/x509.ParseOID":                                     "1.23",
+	"crypto/x509.ParsePKCS1PublicKey":                          "1.10",
+	"crypto/x509.ParseRevocationList":                          "1.19",
+	"crypto/x509.PolicyMapping":                                "1.24",
+	"crypto/x509.PureEd25519":                                  "1.13",
+	"crypto/x509.RevocationList":                               "1.15",
+	"crypto/x509.RevocationListEntry":                          "1.21",
+	"crypto/x509.SHA256WithRSAPSS":                             "1.8",
+	"crypto/x509.SHA384WithRSAPSS":                             "1.8",
+	"crypto/x509.SHA512WithRSAPSS":                             "1.8",
+	"crypto/x509.SetFallbackRoots":                             "1.20",
+	"crypto/x509.SystemCertPool":                               "1.7",
+	"crypto/x509.SystemRootsError":                             "1.1",
+	"crypto/x509.TooManyConstraints":                           "1.10",
+	"crypto/x509.UnconstrainedName":                            "1.10",
+	"crypto/x509/pkix.AttributeTypeAndValueSET":                "1.3",
+	"database/sql.ColumnType":                                  "1.8",
+	"database/sql.Conn":                                        "1.9",
+	"database/sql.ConvertAssign":                               "1.27",
+	"database/sql.DBStats":                                     "1.5",
+	"database/sql.Drivers":                                     "1.4",
+	"database/sql.ErrConnDone":                                 "1.9",
+	"database/sql.IsolationLevel":                              "1.8",
+	"database/sql.LevelDefault":                                "1.8",
+	"database/sql.LevelLinearizable":                           "1.8",
+	"database/sql.LevelReadCommitted":                          "1.8",
+	"database/sql.LevelReadUncommitted":                        "1.8",
+	"database/sql.LevelRepeatableRead":                         "1.8",
+	"database/sql.LevelSerializable":                           "1.8",
+	"database/sql.LevelSnapshot":                               "1.8",
+	"database/sql.LevelWriteCommitted":                         "1.8",
+	"database/sql.Named":                                       "1.8",
+	"database/sql.NamedArg":                                    "1.8",
+	"database/sql.Null":                                        "1.22",
+	"database/sql.NullByte":                                    "1.17",
+	"database/sql.NullInt16":                                   "1.17",
+	"database/sql.NullInt32":                                   "1.13",
+	"database/sql.NullTime":                                    "1.13",
+	"database/sql.OpenDB":                                      "1.10",
+	"database/sql.Out":                                         "1.9",
+	"database/sql.TxOptions":                                   "1.8",
+	"database/sql/driver.ConnBeginTx":                          "1.8",
+	"database/sql/driver.ConnPrepareContext":                   "1.8",
+	"database/sql/driver.Connector":                            "1.10",
+	"database/sql/driver.DriverContext":                        "1.10",
+	"database/sql/driver.ErrRemoveArgument":                    "1.9",
+	"database/sql/driver.ExecerContext":                        "1.8",
+	"database/sql/driver.IsolationLevel":                       "1.8",
+	"database/sql/driver.NamedValue":                           "1.8",
+	"database/sql/driver.NamedValueChecker":                    "1.9",
+	"database/sql/driver.Pinger":                               "1.8",
+	"database/sql/driver.Queryer":                              "1.1",
+	"database/sql/driver.QueryerContext":                       "1.8",
+	"database/sql/driver.RowsColumnScanner":                    "1.27",
+	"database/sql/driver.RowsColumnTypeDatabaseTypeName":       "1.8",
+	"database/sql/driver.RowsColumnTypeLength":                 "1.8",
+	"database/sql/driver.RowsColumnTypeNullable":               "1.8",
+	"database/sql/driver.RowsColumnTypePrecisionScale":         "1.8",
+	"database/sql/driver.RowsColumnTypeScanType":               "1.8",
+	"database/sql/driver.RowsNextResultSet":                    "1.8",
+	"database/sql/driver.ScanContext":                          "1.27",
+	"database/sql/driver.SessionResetter":                      "1.10",
+	"database/sql/driver.StmtExecContext":                      "1.8",
+	"database/sql/driver.StmtQueryContext":                     "1.8",
+	"database/sql/driver.TxOptions":                            "1.8",
+	"database/sql/driver.Validator":                            "1.15",
+	"debug/dwarf.AttrAddrBase":                                 "1.14",
+	"debug/dwarf.AttrAlignment":                                "1.14",
+	"debug/dwarf.AttrBinaryScale":                              "1.14",
+	"debug/dwarf.AttrCallAllCalls":                             "1.14",
+	"debug/dwarf.AttrCallAllSourceCalls":                       "1.14",
+	"debug/dwarf.AttrCallAllTailCalls":                         "1.14",
+	"debug/dwarf.AttrCallDataLocation":                         "1.14",
+	"debug/dwarf.AttrCallDataValue":                            "1.14",
+	"debug/dwarf.AttrCallOrigin":                               "1.14",
+	"debug/dwarf.AttrCallPC":                                   "1.14",
+	"debug/dwarf.AttrCallParameter":                            "1.14",
+	"debug/dwarf.AttrCallReturnPC":                             "1.14",
+	"debug/dwarf.AttrCallTailCall":                             "1.14",
+	"debug/dwarf.AttrCallTarget":                               "1.14",
+	"debug/dwarf.AttrCallTargetClobbered":                      "1.14",
+	"debug/dwarf.AttrCallValue":                                "1.14",
+	"debug/dwarf.AttrConstExpr":                                "1.14",
+	"debug/dwarf.AttrDataBitOffset":                            "1.14",
+	"debug/dwarf.AttrDecimalScale":                             "1.14",
+	"debug/dwarf.AttrDecimalSign":                              "1.14",
+	"debug/dwarf.AttrDefaulted":                                "1.14",
+	"debug/dwarf.AttrDeleted":                                  "1.14",
+	"debug/dwarf.AttrDigitCount":                               "1.14",
+	"debug/dwarf.AttrDwoName":                                  "1.14",
+	"debug/dwarf.AttrElemental":                                "1.14",
+	"debug/dwarf.AttrEndianity":                                "1.14",
+	"debug/dwarf.AttrEnumClass":                                "1.14",
+	"debug/dwarf.AttrExplicit":                                 "1.14",
+	"debug/dwarf.AttrExportSymbols":                            "1.14",
+	"debug/dwarf.AttrLinkageName":                              "1.14",
+	"debug/dwarf.AttrLoclistsBase":                             "1.14",
+	"debug/dwarf.AttrMacros":                                   "1.14",
+	"debug/dwarf.AttrMainSubprogram":                           "1.14",
+	"debug/dwarf.AttrMutable":                                  "1.14",
+	"debug/dwarf.AttrNoreturn":                                 "1.14",
+	"debug/dwarf.AttrObjectPointer":                            "1.14",
+	"debug/dwarf.AttrPictureString":                            "1.14",
+	"debug/dwarf.AttrPure":                                     "1.14",
+	"debug/dwarf.AttrRank":                                     "1.14",
+	"debug/dwarf.AttrRecursive":                                "1.14",
+	"debug/dwarf.AttrReference":                                "1.14",
+	"debug/dwarf.AttrRnglistsBase":                             "1.14",
+	"debug/dwarf.AttrRvalueReference":                          "1.14",
+	"debug/dwarf.AttrSignature":                                "1.14",
+	"debug/dwarf.AttrSmall":                                    "1.14",
+	"debug/dwarf.AttrStrOffsetsBase":                           "1.14",
+	"debug/dwarf.AttrStringLengthBitSize":                      "1.14",
+	"debug/dwarf.AttrStringLengthByteSize":                     "1.14",
+	"debug/dwarf.AttrThreadsScaled":                            "1.14",
+	"debug/dwarf.Class":                                        "1.5",
+	"debug/dwarf.ClassAddrPtr":                                 "1.14",
+	"debug/dwarf.ClassAddress":                                 "1.5",
+	"debug/dwarf.ClassBlock":                                   "1.5",
+	"debug/dwarf.ClassConstant":                                "1.5",
+	"debug/dwarf.ClassExprLoc":                                 "1.5",
+	"debug/dwarf.ClassFlag":                                    "1.5",
+	"debug/dwarf.ClassLinePtr":                                 "1.5",
+	"debug/dwarf.ClassLocList":                                 "1.14",
+	"debug/dwarf.ClassLocListPtr":                              "1.5",
+	"debug/dwarf.ClassMacPtr":                                  "1.5",
+	"debug/dwarf.ClassRangeListPtr":                            "1.5",
+	"debug/dwarf.ClassReference":                               "1.5",
+	"debug/dwarf.ClassReferenceAlt":                            "1.5",
+	"debug/dwarf.ClassReferenceSig":                            "1.5",
+	"debug/dwarf.ClassRngList":                                 "1.14",
+	"debug/dwarf.ClassRngListsPtr":                             "1.14",
+	"debug/dwarf.ClassStrOffsetsPtr":                           "1.14",
+	"debug/dwarf.ClassString":                                  "1.5",
+	"debug/dwarf.ClassStringAlt":                               "1.5",
+	"debug/dwarf.ClassUnknown":                                 "1.6",
+	"debug/dwarf.ErrUnknownPC":                                 "1.5",
+	"debug/dwarf.LineEntry":                                    "1.5",
+	"debug/dwarf.LineFile":                                     "1.5",
+	"debug/dwarf.LineReader":                                   "1.5",
+	"debug/dwarf.LineReaderPos":                                "1.5",
+	"debug/dwarf.TagAtomicType":                                "1.14",
+	"debug/dwarf.TagCallSite":                                  "1.14",
+	"debug/dwarf.TagCallSiteParameter":                         "1.14",
+	"debug/dwarf.TagCoarrayType":                               "1.14",
+	"debug/dwarf.TagCondition":                                 "1.3",
+	"debug/dwarf.TagDynamicType":                               "1.14",
+	"debug/dwarf.TagGenericSubrange":                           "1.14",
+	"debug/dwarf.TagImmutableType":                             "1.14",
+	"debug/dwarf.TagRvalueReferenceType":                       "1.3",
+	"debug/dwarf.TagSharedType":                                "1.3",
+	"debug/dwarf.TagSkeletonUnit":                              "1.14",
+	"debug/dwarf.TagTemplateAlias":                             "1.3",
+	"debug/dwarf.TagTypeUnit":                                  "1.3",
+	"debug/dwarf.UnspecifiedType":                              "1.4",
+	"debug/dwarf.UnsupportedType":                              "1.13",
+	"debug/elf.COMPRESS_HIOS":                                  "1.6",
+	"debug/elf.COMPRESS_HIPROC":                                "1.6",
+	"debug/elf.COMPRESS_LOOS":                                  "1.6",
+	"debug/elf.COMPRESS_LOPROC":                                "1.6",
+	"debug/elf.COMPRESS_ZLIB":                                  "1.6",
+	"debug/elf.COMPRESS_ZSTD":                                  "1.21",
+	"debug/elf.Chdr32":                                         "1.6",
+	"debug/elf.Chdr64":                                         "1.6",
+	"debug/elf.CompressionType":                                "1.6",
+	"debug/elf.DF_1_CONFALT":                                   "1.21",
+	"debug/elf.DF_1_DIRECT":                                    "1.21",
+	"debug/elf.DF_1_DISPRELDNE":                                "1.21",
+	"debug/elf.DF_1_DISPRELPND":                                "1.21",
+	"debug/elf.DF_1_EDITED":                                    "1.21",
+	"debug/elf.DF_1_ENDFILTEE":                                 "1.21",
+	"debug/elf.DF_1_GLOBAL":                                    "1.21",
+	"debug/elf.DF_1_GLOBAUDIT":                                 "1.21",
+	"debug/elf.DF_1_GROUP":                                     "1.21",
+	"debug/elf.DF_1_IGNMULDEF":                                 "1.21",
+	"debug/elf.DF_1_INITFIRST":                                 "1.21",
+	"debug/elf.DF_1_INTERPOSE":                                 "1.21",
+	"debug/elf.DF_1_KMOD":                                      "1.21",
+	"debug/elf.DF_1_LOADFLTR":                                  "1.21",
+	"debug/elf.DF_1_NOCOMMON":                                  "1.21",
+	"debug/elf.DF_1_NODEFLIB":                                  "1.21",
+	"debug/elf.DF_1_NODELETE":                                  "1.21",
+	"debug/elf.DF_1_NODIRECT":                                  "1.21",
+	"debug/elf.DF_1_NODUMP":                                    "1.21",
+	"debug/elf.DF_1_NOHDR":                                     "1.21",
+	"debug/elf.DF_1_NOKSYMS":                                   "1.21",
+	"debug/elf.DF_1_NOOPEN":                                    "1.21",
+	"debug/elf.DF_1_NORELOC":                                   "1.21",
+	"debug/elf.DF_1_NOW":                                       "1.21",
+	"debug/elf.DF_1_ORIGIN":                                    "1.21",
+	"debug/elf.DF_1_PIE":                                       "1.21",
+	"debug/elf.DF_1_SINGLETON":                                 "1.21",
+	"debug/elf.DF_1_STUB":                                      "1.21",
+	"debug/elf.DF_1_SYMINTPOSE":                                "1.21",
+	"debug/elf.DF_1_TRANS":                                     "1.21",
+	"debug/elf.DF_1_WEAKFILTER":                                "1.21",
+	"debug/elf.DT_ADDRRNGHI":                                   "1.16",
+	"debug/elf.DT_ADDRRNGLO":                                   "1.16",
+	"debug/elf.DT_AUDIT":                                       "1.16",
+	"debug/elf.DT_AUXILIARY":                                   "1.16",
+	"debug/elf.DT_CHECKSUM":                                    "1.16",
+	"debug/elf.DT_CONFIG":                                      "1.16",
+	"debug/elf.DT_DEPAUDIT":                                    "1.16",
+	"debug/elf.DT_FEATURE":                                     "1.16",
+	"debug/elf.DT_FILTER":                                      "1.16",
+	"debug/elf.DT_FLAGS_1":                                     "1.16",
+	"debug/elf.DT_GNU_CONFLICT":                                "1.16",
+	"debug/elf.DT_GNU_CONFLICTSZ":                              "1.16",
+	"debug/elf.DT_GNU_HASH":                                    "1.16",
+	"debug/elf.DT_GNU_LIBLIST":                                 "1.16",
+	"debug/elf.DT_GNU_LIBLISTSZ":                               "1.16",
+	"debug/elf.DT_GNU_PRELINKED":                               "1.16",
+	"debug/elf.DT_MIPS_AUX_DYNAMIC":                            "1.16",
+	"debug/elf.DT_MIPS_BASE_ADDRESS":                           "1.16",
+	"debug/elf.DT_MIPS_COMPACT_SIZE":                           "1.16",
+	"debug/elf.DT_MIPS_CONFLICT":                               "1.16",
+	"debug/elf.DT_MIPS_CONFLICTNO":                             "1.16",
+	"debug/elf.DT_MIPS_CXX_FLAGS":                              "1.16",
+	"debug/elf.DT_MIPS_DELTA_CLASS":                            "1.16",
+	"debug/elf.DT_MIPS_DELTA_CLASSSYM":                         "1.16",
+	"debug/elf.DT_MIPS_DELTA_CLASSSYM_NO":                      "1.16",
+	"debug/elf.DT_MIPS_DELTA_CLASS_NO":                         "1.16",
+	"debug/elf.DT_MIPS_DELTA_INSTANCE":                         "1.16",
+	"debug/elf.DT_MIPS_DELTA_INSTANCE_NO":                      "1.16",
+	"debug/elf.DT_MIPS_DELTA_RELOC":                            "1.16",
+	"debug/elf.DT_MIPS_DELTA_RELOC_NO":                         "1.16",
+	"debug/elf.DT_MIPS_DELTA_SYM":                              "1.16",
+	"debug/elf.DT_MIPS_DELTA_SYM_NO":                           "1.16",
+	"debug/elf.DT_MIPS_DYNSTR_ALIGN":                           "1.16",
+	"debug/elf.DT_MIPS_FLAGS":                                  "1.16",
+	"debug/elf.DT_MIPS_GOTSYM":                                 "1.16",
+	"debug/elf.DT_MIPS_GP_VALUE":                               "1.16",
+	"debug/elf.DT_MIPS_HIDDEN_GOTIDX":                          "1.16",
+	"debug/elf.DT_MIPS_HIPAGENO":                               "1.16",
+	"debug/elf.DT_MIPS_ICHECKSUM":                              "1.16",
+	"debug/elf.DT_MIPS_INTERFACE":                              "1.16",
+	"debug/elf.DT_MIPS_INTERFACE_SIZE":                         "1.16",
+	"debug/elf.DT_MIPS_IVERSION":                               "1.16",
+	"debug/elf.DT_MIPS_LIBLIST":                                "1.16",
+	"debug/elf.DT_MIPS_LIBLISTNO":                              "1.16",
+	"debug/elf.DT_MIPS_LOCALPAGE_GOTIDX":                       "1.16",
+	"debug/elf.DT_MIPS_LOCAL_GOTIDX":                           "1.16",
+	"debug/elf.DT_MIPS_LOCAL_GOTNO":                            "1.16",
+	"debug/elf.DT_MIPS_MSYM":                                   "1.16",
+	"debug/elf.DT_MIPS_OPTIONS":                                "1.16",
+	"debug/elf.DT_MIPS_PERF_SUFFIX":                            "1.16",
+	"debug/elf.DT_MIPS_PIXIE_INIT":                             "1.16",
+	"debug/elf.DT_MIPS_PLTGOT":                                 "1.16",
+	"debug/elf.DT_MIPS_PROTECTED_GOTIDX":                       "1.16",
+	"debug/elf.DT_MIPS_RLD_MAP":                                "1.16",
+	"debug/elf.DT_MIPS_RLD_MAP_REL":                            "1.16",
+	"debug/elf.DT_MIPS_RLD_TEXT_RESOLVE_ADDR":                  "1.16",
+	"debug/elf.DT_MIPS_RLD_VERSION":                            "1.16",
+	"debug/elf.DT_MIPS_RWPLT":                                  "1.16",
+	"debug/elf.DT_MIPS_SYMBOL_LIB":                             "1.16",
+	"debug/elf.DT_MIPS_SYMTABNO":                               "1.16",
+	"debug/elf.DT_MIPS_TIME_STAMP":                             "1.16",
+	"debug/elf.DT_MIPS_UNREFEXTNO":                             "1.16",
+	"debug/elf.DT_MOVEENT":                                     "1.16",
+	"debug/elf.DT_MOVESZ":                                      "1.16",
+	"debug/elf.DT_MOVETAB":                                     "1.16",
+	"debug/elf.DT_PLTPAD":                                      "1.16",
+	"debug/elf.DT_PLTPADSZ":                                    "1.16",
+	"debug/elf.DT_POSFLAG_1":                                   "1.16",
+	"debug/elf.DT_PPC64_GLINK":                                 "1.16",
+	"debug/elf.DT_PPC64_OPD":                                   "1.16",
+	"debug/elf.DT_PPC64_OPDSZ":                                 "1.16",
+	"debug/elf.DT_PPC64_OPT":                                   "1.16",
+	"debug/elf.DT_PPC_GOT":                                     "1.16",
+	"debug/elf.DT_PPC_OPT":                                     "1.16",
+	"debug/elf.DT_RELACOUNT":                                   "1.16",
+	"debug/elf.DT_RELCOUNT":                                    "1.16",
+	"debug/elf.DT_SPARC_REGISTER":                              "1.16",
+	"debug/elf.DT_SYMINENT":                                    "1.16",
+	"debug/elf.DT_SYMINFO":                                     "1.16",
+	"debug/elf.DT_SYMINSZ":                                     "1.16",
+	"debug/elf.DT_SYMTAB_SHNDX":                                "1.16",
+	"debug/elf.DT_TLSDESC_GOT":                                 "1.16",
+	"debug/elf.DT_TLSDESC_PLT":                                 "1.16",
+	"debug/elf.DT_USED":                                        "1.16",
+	"debug/elf.DT_VALRNGHI":                                    "1.16",
+	"debug/elf.DT_VALRNGLO":                                    "1.16",
+	"debug/elf.DT_VERDEF":                                      "1.16",
+	"debug/elf.DT_VERDEFNUM":                                   "1.16",
+	"debug/elf.DynFlag1":                                       "1.21",
+	"debug/elf.DynamicVersion":                                 "1.24",
+	"debug/elf.DynamicVersionDep":                              "1.24",
+	"debug/elf.DynamicVersionFlag":                             "1.24",
+	"debug/elf.DynamicVersionNeed":                             "1.24",
+	"debug/elf.ELFOSABI_AROS":                                  "1.11",
+	"debug/elf.ELFOSABI_CLOUDABI":                              "1.11",
+	"debug/elf.ELFOSABI_FENIXOS":                               "1.11",
+	"debug/elf.EM_56800EX":                                     "1.11",
+	"debug/elf.EM_68HC05":                                      "1.11",
+	"debug/elf.EM_68HC08":                                      "1.11",
+	"debug/elf.EM_68HC11":                                      "1.11",
+	"debug/elf.EM_68HC16":                                      "1.11",
+	"debug/elf.EM_78KOR":                                       "1.11",
+	"debug/elf.EM_8051":                                        "1.11",
+	"debug/elf.EM_AARCH64":                                     "1.4",
+	"debug/elf.EM_ALTERA_NIOS2":                                "1.11",
+	"debug/elf.EM_AMDGPU":                                      "1.11",
+	"debug/elf.EM_ARCA":                                        "1.11",
+	"debug/elf.EM_ARC_COMPACT":                                 "1.11",
+	"debug/elf.EM_ARC_COMPACT2":                                "1.11",
+	"debug/elf.EM_AVR":                                         "1.11",
+	"debug/elf.EM_AVR32":                                       "1.11",
+	"debug/elf.EM_BA1":                                         "1.11",
+	"debug/elf.EM_BA2":                                         "1.11",
+	"debug/elf.EM_BLACKFIN":                                    "1.11",
+	"debug/elf.EM_BPF":                                         "1.11",
+	"debug/elf.EM_C166":                                        "1.11",
+	"debug/elf.EM_CDP":                                         "1.11",
+	"debug/elf.EM_CE":                                          "1.11",
+	"debug/elf.EM_CLOUDSHIELD":                                 "1.11",
+	"debug/elf.EM_COGE":                                        "1.11",
+	"debug/elf.EM_COOL":                                        "1.11",
+	"debug/elf.EM_COREA_1ST":                                   "1.11",
+	"debug/elf.EM_COREA_2ND":                                   "1.11",
+	"debug/elf.EM_CR":                                          "1.11",
+	"debug/elf.EM_CR16":                                        "1.11",
+	"debug/elf.EM_CRAYNV2":                                     "1.11",
+	"debug/elf.EM_CRIS":                                        "1.11",
+	"debug/elf.EM_CRX":                                         "1.11",
+	"debug/elf.EM_CSR_KALIMBA":                                 "1.11",
+	"debug/elf.EM_CUDA":                                        "1.11",
+	"debug/elf.EM_CYPRESS_M8C":                                 "1.11",
+	"debug/elf.EM_D10V":                                        "1.11",
+	"debug/elf.EM_D30V":                                        "1.11",
+	"debug/elf.EM_DSP24":                                       "1.11",
+	"debug/elf.EM_DSPIC30F":                                    "1.11",
+	"debug/elf.EM_DXP":                                         "1.11",
+	"debug/elf.EM_ECOG1":                                       "1.11",
+	"debug/elf.EM_ECOG16":                                      "1.11",
+	"debug/elf.EM_ECOG1X":                                      "1.11",
+	"debug/elf.EM_ECOG2":                                       "1.11",
+	"debug/elf.EM_ETPU":                                        "1.11",
+	"debug/elf.EM_EXCESS":                                      "1.11",
+	"debug/elf.EM_F2MC16":                                      "1.11",
+	"debug/elf.EM_FIREPATH":                                    "1.11",
+	"debug/elf.EM_FR30":                                        "1.11",
+	"debug/elf.EM_FT32":                                        "1.11",
+	"debug/elf.EM_FX66":                                        "1.11",
+	"debug/elf.EM_HUANY":                                       "1.11",
+	"debug/elf.EM_INTEL205":                                    "1.11",
+	"debug/elf.EM_INTEL206":                                    "1.11",
+	"debug/elf.EM_INTEL207":                                    "1.11",
+	"debug/elf.EM_INTEL208":                                    "1.11",
+	"debug/elf.EM_INTEL209":                                    "1.11",
+	"debug/elf.EM_IP2K":                                        "1.11",
+	"debug/elf.EM_JAVELIN":                                     "1.11",
+	"debug/elf.EM_K10M":                                        "1.11",
+	"debug/elf.EM_KM32":                                        "1.11",
+	"debug/elf.EM_KMX16":                                       "1.11",
+	"debug/elf.EM_KMX32":                                       "1.11",
+	"debug/elf.EM_KMX8":                                        "1.11",
+	"debug/elf.EM_KVARC":                                       "1.11",
+	"debug/elf.EM_L10M":                                        "1.11",
+	"debug/elf.EM_LANAI":                                       "1.11",
+	"debug/elf.EM_LATTICEMICO32":                               "1.11",
+	"debug/elf.EM_LOONGARCH":                                   "1.19",
+	"debug/elf.EM_M16C":                                        "1.11",
+	"debug/elf.EM_M32C":                                        "1.11",
+	"debug/elf.EM_M32R":                                        "1.11",
+	"debug/elf.EM_MANIK":                                       "1.11",
+	"debug/elf.EM_MAX":                                         "1.11",
+	"debug/elf.EM_MAXQ30":                                      "1.11",
+	"debug/elf.EM_MCHP_PIC":                                    "1.11",
+	"debug/elf.EM_MCST_ELBRUS":                                 "1.11",
+	"debug/elf.EM_METAG":                                       "1.11",
+	"debug/elf.EM_MICROBLAZE":                                  "1.11",
+	"debug/elf.EM_MMDSP_PLUS":                                  "1.11",
+	"debug/elf.EM_MMIX":                                        "1.11",
+	"debug/elf.EM_MN10200":                                     "1.11",
+	"debug/elf.EM_MN10300":                                     "1.11",
+	"debug/elf.EM_MOXIE":                                       "1.11",
+	"debug/elf.EM_MSP430":                                      "1.11",
+	"debug/elf.EM_NDS32":                                       "1.11",
+	"debug/elf.EM_NORC":                                        "1.11",
+	"debug/elf.EM_NS32K":                                       "1.11",
+	"debug/elf.EM_OPEN8":                                       "1.11",
+	"debug/elf.EM_OPENRISC":                                    "1.11",
+	"debug/elf.EM_PDP10":                                       "1.11",
+	"debug/elf.EM_PDP11":                                       "1.11",
+	"debug/elf.EM_PDSP":                                        "1.11",
+	"debug/elf.EM_PJ":                                          "1.11",
+	"debug/elf.EM_PRISM":                                       "1.11",
+	"debug/elf.EM_QDSP6":                                       "1.11",
+	"debug/elf.EM_R32C":                                        "1.11",
+	"debug/elf.EM_RISCV":                                       "1.11",
+	"debug/elf.EM_RL78":                                        "1.11",
+	"debug/elf.EM_RS08":                                        "1.11",
+	"debug/elf.EM_RX":                                          "1.11",
+	"debug/elf.EM_SCORE7":                                      "1.11",
+	"debug/elf.EM_SEP":                                         "1.11",
+	"debug/elf.EM_SE_C17":                                      "1.11",
+	"debug/elf.EM_SE_C33":                                      "1.11",
+	"debug/elf.EM_SHARC":                                       "1.11",
+	"debug/elf.EM_SLE9X":                                       "1.11",
+	"debug/elf.EM_SNP1K":                                       "1.11",
+	"debug/elf.EM_ST19":                                        "1.11",
+	"debug/elf.EM_ST200":                                       "1.11",
+	"debug/elf.EM_ST7":                                         "1.11",
+	"debug/elf.EM_ST9PLUS":                                     "1.11",
+	"debug/elf.EM_STM8":                                        "1.11",
+	"debug/elf.EM_STXP7X":                                      "1.11",
+	"debug/elf.EM_SVX":                                         "1.11",
+	"debug/elf.EM_TILE64":                                      "1.11",
+	"debug/elf.EM_TILEGX":                                      "1.11",
+	"debug/elf.EM_TILEPRO":                                     "1.11",
+	"debug/elf.EM_TI_ARP32":                                    "1.11",
+	"debug/elf.EM_TI_C2000":                                    "1.11",
+	"debug/elf.EM_TI_C5500":                                    "1.11",
+	"debug/elf.EM_TI_C6000":                                    "1.11",
+	"debug/elf.EM_TI_PRU":                                      "1.11",
+	"debug/elf.EM_TMM_GPP":                                     "1.11",
+	"debug/elf.EM_TPC":                                         "1.11",
+	"debug/elf.EM_TRIMEDIA":                                    "1.11",
+	"debug/elf.EM_TSK3000":                                     "1.11",
+	"debug/elf.EM_UNICORE":                                     "1.11",
+	"debug/elf.EM_V850":                                        "1.11",
+	"debug/elf.EM_VAX":                                         "1.11",
+	"debug/elf.EM_VIDEOCORE":                                   "1.11",
+	"debug/elf.EM_VIDEOCORE3":                                  "1.11",
+	"debug/elf.EM_VIDEOCORE5":                                  "1.11",
+	"debug/elf.EM_VISIUM":                                      "1.11",
+	"debug/elf.EM_XCORE":                                       "1.11",
+	"debug/elf.EM_XGATE":                                       "1.11",
+	"debug/elf.EM_XIMO16":                                      "1.11",
+	"debug/elf.EM_XTENSA":                                      "1.11",
+	"debug/elf.EM_Z80":                                         "1.11",
+	"debug/elf.EM_ZSP":                                         "1.11",
+	"debug/elf.ErrNoSymbols":                                   "1.4",
+	"debug/elf.PT_AARCH64_ARCHEXT":                             "1.16",
+	"debug/elf.PT_AARCH64_UNWIND":                              "1.16",
+	"debug/elf.PT_ARM_ARCHEXT":                                 "1.16",
+	"debug/elf.PT_ARM_EXIDX":                                   "1.16",
+	"debug/elf.PT_GNU_EH_FRAME":                                "1.16",
+	"debug/elf.PT_GNU_MBIND_HI":                                "1.16",
+	"debug/elf.PT_GNU_MBIND_LO":                                "1.16",
+	"debug/elf.PT_GNU_PROPERTY":                                "1.16",
+	"debug/elf.PT_GNU_RELRO":                                   "1.16",
+	"debug/elf.PT_GNU_STACK":                                   "1.16",
+	"debug/elf.PT_MIPS_ABIFLAGS":                               "1.16",
+	"debug/elf.PT_MIPS_OPTIONS":                                "1.16",
+	"debug/elf.PT_MIPS_REGINFO":                                "1.16",
+	"debug/elf.PT_MIPS_RTPROC":                                 "1.16",
+	"debug/elf.PT_OPENBSD_BOOTDATA":                            "1.16",
+	"debug/elf.PT_OPENBSD_NOBTCFI":                             "1.23",
+	"debug/elf.PT_OPENBSD_RANDOMIZE":                           "1.16",
+	"debug/elf.PT_OPENBSD_WXNEEDED":                            "1.16",
+	"debug/elf.PT_PAX_FLAGS":                                   "1.16",
+	"debug/elf.PT_RISCV_ATTRIBUTES":                            "1.25",
+	"debug/elf.PT_S390_PGSTE":                                  "1.16",
+	"debug/elf.PT_SUNWSTACK":                                   "1.16",
+	"debug/elf.PT_SUNW_EH_FRAME":                               "1.16",
+	"debug/elf.R_386_16":                                       "1.10",
+	"debug/elf.R_386_32PLT":                                    "1.10",
+	"debug/elf.R_386_8":                                        "1.10",
+	"debug/elf.R_386_GOT32X":                                   "1.10",
+	"debug/elf.R_386_IRELATIVE":                                "1.10",
+	"debug/elf.R_386_PC16":                                     "1.10",
+	"debug/elf.R_386_PC8":                                      "1.10",
+	"debug/elf.R_386_SIZE32":                                   "1.10",
+	"debug/elf.R_386_TLS_DESC":                                 "1.10",
+	"debug/elf.R_386_TLS_DESC_CALL":                            "1.10",
+	"debug/elf.R_386_TLS_GOTDESC":                              "1.10",
+	"debug/elf.R_390":                                          "1.7",
+	"debug/elf.R_390_12":                                       "1.7",
+	"debug/elf.R_390_16":                                       "1.7",
+	"debug/elf.R_390_20":                                       "1.7",
+	"debug/elf.R_390_32":                                       "1.7",
+	"debug/elf.R_390_64":                                       "1.7",
+	"debug/elf.R_390_8":                                        "1.7",
+	"debug/elf.R_390_COPY":                                     "1.7",
+	"debug/elf.R_390_GLOB_DAT":                                 "1.7",
+	"debug/elf.R_390_GOT12":                                    "1.7",
+	"debug/elf.R_390_GOT16":                                    "1.7",
+	"debug/elf.R_390_GOT20":                                    "1.7",
+	"debug/elf.R_390_GOT32":                                    "1.7",
+	"debug/elf.R_390_GOT64":                                    "1.7",
+	"debug/elf.R_390_GOTENT":                                   "1.7",
+	"debug/elf.R_390_GOTOFF":                                   "1.7",
+	"debug/elf.R_390_GOTOFF16":                                 "1.7",
+	"debug/elf.R_390_GOTOFF64":                                 "1.7",
+	"debug/elf.R_390_GOTPC":                                    "1.7",
+	"debug/elf.R_390_GOTPCDBL":                                 "1.7",
+	"debug/elf.R_390_GOTPLT12":                                 "1.7",
+	"debug/elf.R_390_GOTPLT16":                                 "1.7",
+	"debug/elf.R_390_GOTPLT20":                                 "1.7",
+	"debug/elf.R_390_GOTPLT32":                                 "1.7",
+	"debug/elf.R_390_GOTPLT64":                                 "1.7",
+	"debug/elf.R_390_GOTPLTENT":                                "1.7",
+	"debug/elf.R_390_GOTPLTOFF16":                              "1.7",
+	"debug/elf.R_390_GOTPLTOFF32":                              "1.7",
+	"debug/elf.R_390_GOTPLTOFF64":                              "1.7",
+	"debug/elf.R_390_JMP_SLOT":                                 "1.7",
+	"debug/elf.R_390_NONE":                                     "1.7",
+	"debug/elf.R_390_PC16":                                     "1.7",
+	"debug/elf.R_390_PC16DBL":                                  "1.7",
+	"debug/elf.R_390_PC32":                                     "1.7",
+	"debug/elf.R_390_PC32DBL":                                  "1.7",
+	"debug/elf.R_390_PC64":                                     "1.7",
+	"debug/elf.R_390_PLT16DBL":                                 "1.7",
+	"debug/elf.R_390_PLT32":                                    "1.7",
+	"debug/elf.R_390_PLT32DBL":                                 "1.7",
+	"debug/elf.R_390_PLT64":                                    "1.7",
+	"debug/elf.R_390_RELATIVE":                                 "1.7",
+	"debug/elf.R_390_TLS_DTPMOD":                               "1.7",
+	"debug/elf.R_390_TLS_DTPOFF":                               "1.7",
+	"debug/elf.R_390_TLS_GD32":                                 "1.7",
+	"debug/elf.R_390_TLS_GD64":                                 "1.7",
+	"debug/elf.R_390_TLS_GDCALL":                               "1.7",
+	"debug/elf.R_390_TLS_GOTIE12":                              "1.7",
+	"debug/elf.R_390_TLS_GOTIE20":                              "1.7",
+	"debug/elf.R_390_TLS_GOTIE32":                              "1.7",
+	"debug/elf.R_390_TLS_GOTIE64":                              "1.7",
+	"debug/elf.R_390_TLS_IE32":                                 "1.7",
+	"debug/elf.R_390_TLS_IE64":                                 "1.7",
+	"debug/elf.R_390_TLS_IEENT":                                "1.7",
+	"debug/elf.R_390_TLS_LDCALL":                               "1.7",
+	"debug/elf.R_390_TLS_LDM32":                                "1.7",
+	"debug/elf.R_390_TLS_LDM64":                                "1.7",
+	"debug/elf.R_390_TLS_LDO32":                                "1.7",
+	"debug/elf.R_390_TLS_LDO64":                                "1.7",
+	"debug/elf.R_390_TLS_LE32":                                 "1.7",
+	"debug/elf.R_390_TLS_LE64":                                 "1.7",
+	"debug/elf.R_390_TLS_LOAD":                                 "1.7",
+	"debug/elf.R_390_TLS_TPOFF":                                "1.7",
+	"debug/elf.R_AARCH64":                                      "1.4",
+	"debug/elf.R_AARCH64_ABS16":                                "1.4",
+	"debug/elf.R_AARCH64_ABS32":                                "1.4",
+	"debug/elf.R_AARCH64_ABS64":                                "1.4",
+	"debug/elf.R_AARCH64_ADD_ABS_LO12_NC":                      "1.4",
+	"debug/elf.R_AARCH64_ADR_GOT_PAGE":                         "1.4",
+	"debug/elf.R_AARCH64_ADR_PREL_LO21":                        "1.4",
+	"debug/elf.R_AARCH64_ADR_PREL_PG_HI21":                     "1.4",
+	"debug/elf.R_AARCH64_ADR_PREL_PG_HI21_NC":                  "1.4",
+	"debug/elf.R_AARCH64_CALL26":                               "1.4",
+	"debug/elf.R_AARCH64_CONDBR19":                             "1.4",
+	"debug/elf.R_AARCH64_COPY":                                 "1.4",
+	"debug/elf.R_AARCH64_GLOB_DAT":                             "1.4",
+	"debug/elf.R_AARCH64_GOT_LD_PREL19":                        "1.4",
+	"debug/elf.R_AARCH64_IRELATIVE":                            "1.4",
+	"debug/elf.R_AARCH64_JUMP26":                               "1.4",
+	"debug/elf.R_AARCH64_JUMP_SLOT":                            "1.4",
+	"debug/elf.R_AARCH64_LD64_GOTOFF_LO15":                     "1.10",
+	"debug/elf.R_AARCH64_LD64_GOTPAGE_LO15":                    "1.10",
+	"debug/elf.R_AARCH64_LD64_GOT_LO12_NC":                     "1.4",
+	"debug/elf.R_AARCH64_LDST128_ABS_LO12_NC":                  "1.4",
+	"debug/elf.R_AARCH64_LDST16_ABS_LO12_NC":                   "1.4",
+	"debug/elf.R_AARCH64_LDST32_ABS_LO12_NC":                   "1.4",
+	"debug/elf.R_AARCH64_LDST64_ABS_LO12_NC":                   "1.4",
+	"debug/elf.R_AARCH64_LDST8_ABS_LO12_NC":                    "1.4",
+	"debug/elf.R_AARCH64_LD_PREL_LO19":                         "1.4",
+	"debug/elf.R_AARCH64_MOVW_SABS_G0":                         "1.4",
+	"debug/elf.R_AARCH64_MOVW_SABS_G1":                         "1.4",
+	"debug/elf.R_AARCH64_MOVW_SABS_G2":                         "1.4",
+	"debug/elf.R_AARCH64_MOVW_UABS_G0":                         "1.4",
+	"debug/elf.R_AARCH64_MOVW_UABS_G0_NC":                      "1.4",
+	"debug/elf.R_AARCH64_MOVW_UABS_G1":                         "1.4",
+	"debug/elf.R_AARCH64_MOVW_UABS_G1_NC":                      "1.4",
+	"debug/elf.R_AARCH64_MOVW_UABS_G2":                         "1.4",
+	"debug/elf.R_AARCH64_MOVW_UABS_G2_NC":                      "1.4",
+	"debug/elf.R_AARCH64_MOVW_UABS_G3":                         "1.4",
+	"debug/elf.R_AARCH64_NONE":                                 "1.4",
+	"debug/elf.R_AARCH64_NULL":                                 "1.4",
+	"debug/elf.R_AARCH64_P32_ABS16":                            "1.4",
+	"debug/elf.R_AARCH64_P32_ABS32":                            "1.4",
+	"debug/elf.R_AARCH64_P32_ADD_ABS_LO12_NC":                  "1.4",
+	"debug/elf.R_AARCH64_P32_ADR_GOT_PAGE":                     "1.4",
+	"debug/elf.R_AARCH64_P32_ADR_PREL_LO21":                    "1.4",
+	"debug/elf.R_AARCH64_P32_ADR_PREL_PG_HI21":                 "1.4",
+	"debug/elf.R_AARCH64_P32_CALL26":                           "1.4",
+	"debug/elf.R_AARCH64_P32_CONDBR19":                         "1.4",
+	"debug/elf.R_AARCH64_P32_COPY":                             "1.4",
+	"debug/elf.R_AARCH64_P32_GLOB_DAT":                         "1.4",
+	"debug/elf.R_AARCH64_P32_GOT_LD_PREL19":                    "1.4",
+	"debug/elf.R_AARCH64_P32_IRELATIVE":                        "1.4",
+	"debug/elf.R_AARCH64_P32_JUMP26":                           "1.4",
+	"debug/elf.R_AARCH64_P32_JUMP_SLOT":                        "1.4",
+	"debug/elf.R_AARCH64_P32_LD32_GOT_LO12_NC":                 "1.4",
+	"debug/elf.R_AARCH64_P32_LDST128_ABS_LO12_NC":              "1.4",
+	"debug/elf.R_AARCH64_P32_LDST16_ABS_LO12_NC":               "1.4",
+	"debug/elf.R_AARCH64_P32_LDST32_ABS_LO12_NC":               "1.4",
+	"debug/elf.R_AARCH64_P32_LDST64_ABS_LO12_NC":               "1.4",
+	"debug/elf.R_AARCH64_P32_LDST8_ABS_LO12_NC":                "1.4",
+	"debug/elf.R_AARCH64_P32_LD_PREL_LO19":                     "1.4",
+	"debug/elf.R_AARCH64_P32_MOVW_SABS_G0":                     "1.4",
+	"debug/elf.R_AARCH64_P32_MOVW_UABS_G0":                     "1.4",
+	"debug/elf.R_AARCH64_P32_MOVW_UABS_G0_NC":                  "1.4",
+	"debug/elf.R_AARCH64_P32_MOVW_UABS_G1":                     "1.4",
+	"debug/elf.R_AARCH64_P32_PREL16":                           "1.4",
+	"debug/elf.R_AARCH64_P32_PREL32":                           "1.4",
+	"debug/elf.R_AARCH64_P32_RELATIVE":                         "1.4",
+	"debug/elf.R_AARCH64_P32_TLSDESC":                          "1.4",
+	"debug/elf.R_AARCH64_P32_TLSDESC_ADD_LO12_NC":              "1.4",
+	"debug/elf.R_AARCH64_P32_TLSDESC_ADR_PAGE21":               "1.4",
+	"debug/elf.R_AARCH64_P32_TLSDESC_ADR_PREL21":               "1.4",
+	"debug/elf.R_AARCH64_P32_TLSDESC_CALL":                     "1.4",
+	"debug/elf.R_AARCH64_P32_TLSDESC_LD32_LO12_NC":             "1.4",
+	"debug/elf.R_AARCH64_P32_TLSDESC_LD_PREL19":                "1.4",
+	"debug/elf.R_AARCH64_P32_TLSGD_ADD_LO12_NC":                "1.4",
+	"debug/elf.R_AARCH64_P32_TLSGD_ADR_PAGE21":                 "1.4",
+	"debug/elf.R_AARCH64_P32_TLSIE_ADR_GOTTPREL_PAGE21":        "1.4",
+	"debug/elf.R_AARCH64_P32_TLSIE_LD32_GOTTPREL_LO12_NC":      "1.4",
+	"debug/elf.R_AARCH64_P32_TLSIE_LD_GOTTPREL_PREL19":         "1.4",
+	"debug/elf.R_AARCH64_P32_TLSLE_ADD_TPREL_HI12":             "1.4",
+	"debug/elf.R_AARCH64_P32_TLSLE_ADD_TPREL_LO12":             "1.4",
+	"debug/elf.R_AARCH64_P32_TLSLE_ADD_TPREL_LO12_NC":          "1.4",
+	"debug/elf.R_AARCH64_P32_TLSLE_MOVW_TPREL_G0":              "1.4",
+	"debug/elf.R_AARCH64_P32_TLSLE_MOVW_TPREL_G0_NC":           "1.4",
+	"debug/elf.R_AARCH64_P32_TLSLE_MOVW_TPREL_G1":              "1.4",
+	"debug/elf.R_AARCH64_P32_TLS_DTPMOD":                       "1.4",
+	"debug/elf.R_AARCH64_P32_TLS_DTPREL":                       "1.4",
+	"debug/elf.R_AARCH64_P32_TLS_TPREL":                        "1.4",
+	"debug/elf.R_AARCH64_P32_TSTBR14":                          "1.4",
+	"debug/elf.R_AARCH64_PREL16":                               "1.4",
+	"debug/elf.R_AARCH64_PREL32":                               "1.4",
+	"debug/elf.R_AARCH64_PREL64":                               "1.4",
+	"debug/elf.R_AARCH64_RELATIVE":                             "1.4",
+	"debug/elf.R_AARCH64_TLSDESC":                              "1.4",
+	"debug/elf.R_AARCH64_TLSDESC_ADD":                          "1.4",
+	"debug/elf.R_AARCH64_TLSDESC_ADD_LO12_NC":                  "1.4",
+	"debug/elf.R_AARCH64_TLSDESC_ADR_PAGE21":                   "1.4",
+	"debug/elf.R_AARCH64_TLSDESC_ADR_PREL21":                   "1.4",
+	"debug/elf.R_AARCH64_TLSDESC_CALL":                         "1.4",
+	"debug/elf.R_AARCH64_TLSDESC_LD64_LO12_NC":                 "1.4",
+	"debug/elf.R_AARCH64_TLSDESC_LDR":                          "1.4",
+	"debug/elf.R_AARCH64_TLSDESC_LD_PREL19":                    "1.4",
+	"debug/elf.R_AARCH64_TLSDESC_OFF_G0_NC":                    "1.4",
+	"debug/elf.R_AARCH64_TLSDESC_OFF_G1":                       "1.4",
+	"debug/elf.R_AARCH64_TLSGD_ADD_LO12_NC":                    "1.4",
+	"debug/elf.R_AARCH64_TLSGD_ADR_PAGE21":                     "1.4",
+	"debug/elf.R_AARCH64_TLSGD_ADR_PREL21":                     "1.10",
+	"debug/elf.R_AARCH64_TLSGD_MOVW_G0_NC":                     "1.10",
+	"debug/elf.R_AARCH64_TLSGD_MOVW_G1":                        "1.10",
+	"debug/elf.R_AARCH64_TLSIE_ADR_GOTTPREL_PAGE21":            "1.4",
+	"debug/elf.R_AARCH64_TLSIE_LD64_GOTTPREL_LO12_NC":          "1.4",
+	"debug/elf.R_AARCH64_TLSIE_LD_GOTTPREL_PREL19":             "1.4",
+	"debug/elf.R_AARCH64_TLSIE_MOVW_GOTTPREL_G0_NC":            "1.4",
+	"debug/elf.R_AARCH64_TLSIE_MOVW_GOTTPREL_G1":               "1.4",
+	"debug/elf.R_AARCH64_TLSLD_ADR_PAGE21":                     "1.10",
+	"debug/elf.R_AARCH64_TLSLD_ADR_PREL21":                     "1.10",
+	"debug/elf.R_AARCH64_TLSLD_LDST128_DTPREL_LO12":            "1.10",
+	"debug/elf.R_AARCH64_TLSLD_LDST128_DTPREL_LO12_NC":         "1.10",
+	"debug/elf.R_AARCH64_TLSLE_ADD_TPREL_HI12":                 "1.4",
+	"debug/elf.R_AARCH64_TLSLE_ADD_TPREL_LO12":                 "1.4",
+	"debug/elf.R_AARCH64_TLSLE_ADD_TPREL_LO12_NC":              "1.4",
+	"debug/elf.R_AARCH64_TLSLE_LDST128_TPREL_LO12":             "1.10",
+	"debug/elf.R_AARCH64_TLSLE_LDST128_TPREL_LO12_NC":          "1.10",
+	"debug/elf.R_AARCH64_TLSLE_MOVW_TPREL_G0":                  "1.4",
+	"debug/elf.R_AARCH64_TLSLE_MOVW_TPREL_G0_NC":               "1.4",
+	"debug/elf.R_AARCH64_TLSLE_MOVW_TPREL_G1":                  "1.4",
+	"debug/elf.R_AARCH64_TLSLE_MOVW_TPREL_G1_NC":               "1.4",
+	"debug/elf.R_AARCH64_TLSLE_MOVW_TPREL_G2":                  "1.4",
+	"debug/elf.R_AARCH64_TLS_DTPMOD64":                         "1.4",
+	"debug/elf.R_AARCH64_TLS_DTPREL64":                         "1.4",
+	"debug/elf.R_AARCH64_TLS_TPREL64":                          "1.4",
+	"debug/elf.R_AARCH64_TSTBR14":                              "1.4",
+	"debug/elf.R_ARM_ABS32_NOI":                                "1.10",
+	"debug/elf.R_ARM_ALU_PCREL_15_8":                           "1.10",
+	"debug/elf.R_ARM_ALU_PCREL_23_15":                          "1.10",
+	"debug/elf.R_ARM_ALU_PCREL_7_0":                            "1.10",
+	"debug/elf.R_ARM_ALU_PC_G0":                                "1.10",
+	"debug/elf.R_ARM_ALU_PC_G0_NC":                             "1.10",
+	"debug/elf.R_ARM_ALU_PC_G1":                                "1.10",
+	"debug/elf.R_ARM_ALU_PC_G1_NC":                             "1.10",
+	"debug/elf.R_ARM_ALU_PC_G2":                                "1.10",
+	"debug/elf.R_ARM_ALU_SBREL_19_12_NC":                       "1.10",
+	"debug/elf.R_ARM_ALU_SBREL_27_20_CK":                       "1.10",
+	"debug/elf.R_ARM_ALU_SB_G0":                                "1.10",
+	"debug/elf.R_ARM_ALU_SB_G0_NC":                             "1.10",
+	"debug/elf.R_ARM_ALU_SB_G1":                                "1.10",
+	"debug/elf.R_ARM_ALU_SB_G1_NC":                             "1.10",
+	"debug/elf.R_ARM_ALU_SB_G2":                                "1.10",
+	"debug/elf.R_ARM_BASE_ABS":                                 "1.10",
+	"debug/elf.R_ARM_CALL":                                     "1.10",
+	"debug/elf.R_ARM_GOTOFF12":                                 "1.10",
+	"debug/elf.R_ARM_GOTRELAX":                                 "1.10",
+	"debug/elf.R_ARM_GOT_ABS":                                  "1.10",
+	"debug/elf.R_ARM_GOT_BREL12":                               "1.10",
+	"debug/elf.R_ARM_GOT_PREL":                                 "1.10",
+	"debug/elf.R_ARM_IRELATIVE":                                "1.10",
+	"debug/elf.R_ARM_JUMP24":                                   "1.10",
+	"debug/elf.R_ARM_LDC_PC_G0":                                "1.10",
+	"debug/elf.R_ARM_LDC_PC_G1":                                "1.10",
+	"debug/elf.R_ARM_LDC_PC_G2":                                "1.10",
+	"debug/elf.R_ARM_LDC_SB_G0":                                "1.10",
+	"debug/elf.R_ARM_LDC_SB_G1":                                "1.10",
+	"debug/elf.R_ARM_LDC_SB_G2":                                "1.10",
+	"debug/elf.R_ARM_LDRS_PC_G0":                               "1.10",
+	"debug/elf.R_ARM_LDRS_PC_G1":                               "1.10",
+	"debug/elf.R_ARM_LDRS_PC_G2":                               "1.10",
+	"debug/elf.R_ARM_LDRS_SB_G0":                               "1.10",
+	"debug/elf.R_ARM_LDRS_SB_G1":                               "1.10",
+	"debug/elf.R_ARM_LDRS_SB_G2":                               "1.10",
+	"debug/elf.R_ARM_LDR_PC_G1":                                "1.10",
+	"debug/elf.R_ARM_LDR_PC_G2":                                "1.10",
+	"debug/elf.R_ARM_LDR_SBREL_11_10_NC":                       "1.10",
+	"debug/elf.R_ARM_LDR_SB_G0":                                "1.10",
+	"debug/elf.R_ARM_LDR_SB_G1":                                "1.10",
+	"debug/elf.R_ARM_LDR_SB_G2":                                "1.10",
+	"debug/elf.R_ARM_ME_TOO":                                   "1.10",
+	"debug/elf.R_ARM_MOVT_ABS":                                 "1.10",
+	"debug/elf.R_ARM_MOVT_BREL":                                "1.10",
+	"debug/elf.R_ARM_MOVT_PREL":                                "1.10",
+	"debug/elf.R_ARM_MOVW_ABS_NC":                              "1.10",
+	"debug/elf.R_ARM_MOVW_BREL":                                "1.10",
+	"debug/elf.R_ARM_MOVW_BREL_NC":                             "1.10",
+	"debug/elf.R_ARM_MOVW_PREL_NC":                             "1.10",
+	"debug/elf.R_ARM_PLT32_ABS":                                "1.10",
+	"debug/elf.R_ARM_PREL31":                                   "1.10",
+	"debug/elf.R_ARM_PRIVATE_0":                                "1.10",
+	"debug/elf.R_ARM_PRIVATE_1":                                "1.10",
+	"debug/elf.R_ARM_PRIVATE_10":                               "1.10",
+	"debug/elf.R_ARM_PRIVATE_11":                               "1.10",
+	"debug/elf.R_ARM_PRIVATE_12":                               "1.10",
+	"debug/elf.R_ARM_PRIVATE_13":                               "1.10",
+	"debug/elf.R_ARM_PRIVATE_14":                               "1.10",
+	"debug/elf.R_ARM_PRIVATE_15":                               "1.10",
+	"debug/elf.R_ARM_PRIVATE_2":                                "1.10",
+	"debug/elf.R_ARM_PRIVATE_3":                                "1.10",
+	"debug/elf.R_ARM_PRIVATE_4":                                "1.10",
+	"debug/elf.R_ARM_PRIVATE_5":                                "1.10",
+	"debug/elf.R_ARM_PRIVATE_6":                                "1.10",
+	"debug/elf.R_ARM_PRIVATE_7":                                "1.10",
+	"debug/elf.R_ARM_PRIVATE_8":                                "1.10",
+	"debug/elf.R_ARM_PRIVATE_9":                                "1.10",
+	"debug/elf.R_ARM_REL32_NOI":                                "1.10",
+	"debug/elf.R_ARM_RXPC25":                                   "1.10",
+	"debug/elf.R_ARM_SBREL31":                                  "1.10",
+	"debug/elf.R_ARM_TARGET1":                                  "1.10",
+	"debug/elf.R_ARM_TARGET2":                                  "1.10",
+	"debug/elf.R_ARM_THM_ALU_ABS_G0_NC":                        "1.10",
+	"debug/elf.R_ARM_THM_ALU_ABS_G1_NC":                        "1.10",
+	"debug/elf.R_ARM_THM_ALU_ABS_G2_NC":                        "1.10",
+	"debug/elf.R_ARM_THM_ALU_ABS_G3":                           "1.10",
+	"debug/elf.R_ARM_THM_ALU_PREL_11_0":                        "1.10",
+	"debug/elf.R_ARM_THM_GOT_BREL12":                           "1.10",
+	"debug/elf.R_ARM_THM_JUMP11":                               "1.10",
+	"debug/elf.R_ARM_THM_JUMP19":                               "1.10",
+	"debug/elf.R_ARM_THM_JUMP24":                               "1.10",
+	"debug/elf.R_ARM_THM_JUMP6":                                "1.10",
+	"debug/elf.R_ARM_THM_JUMP8":                                "1.10",
+	"debug/elf.R_ARM_THM_MOVT_ABS":                             "1.10",
+	"debug/elf.R_ARM_THM_MOVT_BREL":                            "1.10",
+	"debug/elf.R_ARM_THM_MOVT_PREL":                            "1.10",
+	"debug/elf.R_ARM_THM_MOVW_ABS_NC":                          "1.10",
+	"debug/elf.R_ARM_THM_MOVW_BREL":                            "1.10",
+	"debug/elf.R_ARM_THM_MOVW_BREL_NC":                         "1.10",
+	"debug/elf.R_ARM_THM_MOVW_PREL_NC":                         "1.10",
+	"debug/elf.R_ARM_THM_PC12":                                 "1.10",
+	"debug/elf.R_ARM_THM_TLS_CALL":                             "1.10",
+	"debug/elf.R_ARM_THM_TLS_DESCSEQ16":                        "1.10",
+	"debug/elf.R_ARM_THM_TLS_DESCSEQ32":                        "1.10",
+	"debug/elf.R_ARM_TLS_CALL":                                 "1.10",
+	"debug/elf.R_ARM_TLS_DESCSEQ":                              "1.10",
+	"debug/elf.R_ARM_TLS_DTPMOD32":                             "1.10",
+	"debug/elf.R_ARM_TLS_DTPOFF32":                             "1.10",
+	"debug/elf.R_ARM_TLS_GD32":                                 "1.10",
+	"debug/elf.R_ARM_TLS_GOTDESC":                              "1.10",
+	"debug/elf.R_ARM_TLS_IE12GP":                               "1.10",
+	"debug/elf.R_ARM_TLS_IE32":                                 "1.10",
+	"debug/elf.R_ARM_TLS_LDM32":                                "1.10",
+	"debug/elf.R_ARM_TLS_LDO12":                                "1.10",
+	"debug/elf.R_ARM_TLS_LDO32":                                "1.10",
+	"debug/elf.R_ARM_TLS_LE12":                                 "1.10",
+	"debug/elf.R_ARM_TLS_LE32":                                 "1.10",
+	"debug/elf.R_ARM_TLS_TPOFF32":                              "1.10",
+	"debug/elf.R_ARM_V4BX":                                     "1.10",
+	"debug/elf.R_LARCH":                                        "1.19",
+	"debug/elf.R_LARCH_32":                                     "1.19",
+	"debug/elf.R_LARCH_32_PCREL":                               "1.20",
+	"debug/elf.R_LARCH_64":                                     "1.19",
+	"debug/elf.R_LARCH_64_PCREL":                               "1.22",
+	"debug/elf.R_LARCH_ABS64_HI12":                             "1.20",
+	"debug/elf.R_LARCH_ABS64_LO20":                             "1.20",
+	"debug/elf.R_LARCH_ABS_HI20":                               "1.20",
+	"debug/elf.R_LARCH_ABS_LO12":                               "1.20",
+	"debug/elf.R_LARCH_ADD16":                                  "1.19",
+	"debug/elf.R_LARCH_ADD24":                                  "1.19",
+	"debug/elf.R_LARCH_ADD32":                                  "1.19",
+	"debug/elf.R_LARCH_ADD6":                                   "1.22",
+	"debug/elf.R_LARCH_ADD64":                                  "1.19",
+	"debug/elf.R_LARCH_ADD8":                                   "1.19",
+	"debug/elf.R_LARCH_ADD_ULEB128":                            "1.22",
+	"debug/elf.R_LARCH_ALIGN":                                  "1.22",
+	"debug/elf.R_LARCH_B16":                                    "1.20",
+	"debug/elf.R_LARCH_B21":                                    "1.20",
+	"debug/elf.R_LARCH_B26":                                    "1.20",
+	"debug/elf.R_LARCH_CALL36":                                 "1.26",
+	"debug/elf.R_LARCH_CFA":                                    "1.22",
+	"debug/elf.R_LARCH_COPY":                                   "1.19",
+	"debug/elf.R_LARCH_DELETE":                                 "1.22",
+	"debug/elf.R_LARCH_GNU_VTENTRY":                            "1.20",
+	"debug/elf.R_LARCH_GNU_VTINHERIT":                          "1.20",
+	"debug/elf.R_LARCH_GOT64_HI12":                             "1.20",
+	"debug/elf.R_LARCH_GOT64_LO20":                             "1.20",
+	"debug/elf.R_LARCH_GOT64_PC_HI12":                          "1.20",
+	"debug/elf.R_LARCH_GOT64_PC_LO20":                          "1.20",
+	"debug/elf.R_LARCH_GOT_HI20":                               "1.20",
+	"debug/elf.R_LARCH_GOT_LO12":                               "1.20",
+	"debug/elf.R_LARCH_GOT_PC_HI20":                            "1.20",
+	"debug/elf.R_LARCH_GOT_PC_LO12":                            "1.20",
+	"debug/elf.R_LARCH_IRELATIVE":                              "1.19",
+	"debug/elf.R_LARCH_JUMP_SLOT":                              "1.19",
+	"debug/elf.R_LARCH_MARK_LA":                                "1.19",
+	"debug/elf.R_LARCH_MARK_PCREL":                             "1.19",
+	"debug/elf.R_LARCH_NONE":                                   "1.19",
+	"debug/elf.R_LARCH_PCALA64_HI12":                           "1.20",
+	"debug/elf.R_LARCH_PCALA64_LO20":                           "1.20",
+	"debug/elf.R_LARCH_PCALA_HI20":                             "1.20",
+	"debug/elf.R_LARCH_PCALA_LO12":                             "1.20",
+	"debug/elf.R_LARCH_PCREL20_S2":                             "1.22",
+	"debug/elf.R_LARCH_RELATIVE":                               "1.19",
+	"debug/elf.R_LARCH_RELAX":                                  "1.20",
+	"debug/elf.R_LARCH_SOP_ADD":                                "1.19",
+	"debug/elf.R_LARCH_SOP_AND":                                "1.19",
+	"debug/elf.R_LARCH_SOP_ASSERT":                             "1.19",
+	"debug/elf.R_LARCH_SOP_IF_ELSE":                            "1.19",
+	"debug/elf.R_LARCH_SOP_NOT":                                "1.19",
+	"debug/elf.R_LARCH_SOP_POP_32_S_0_10_10_16_S2":             "1.19",
+	"debug/elf.R_LARCH_SOP_POP_32_S_0_5_10_16_S2":              "1.19",
+	"debug/elf.R_LARCH_SOP_POP_32_S_10_12":                     "1.19",
+	"debug/elf.R_LARCH_SOP_POP_32_S_10_16":                     "1.19",
+	"debug/elf.R_LARCH_SOP_POP_32_S_10_16_S2":                  "1.19",
+	"debug/elf.R_LARCH_SOP_POP_32_S_10_5":                      "1.19",
+	"debug/elf.R_LARCH_SOP_POP_32_S_5_20":                      "1.19",
+	"debug/elf.R_LARCH_SOP_POP_32_U":                           "1.19",
+	"debug/elf.R_LARCH_SOP_POP_32_U_10_12":                     "1.19",
+	"debug/elf.R_LARCH_SOP_PUSH_ABSOLUTE":                      "1.19",
+	"debug/elf.R_LARCH_SOP_PUSH_DUP":                           "1.19",
+	"debug/elf.R_LARCH_SOP_PUSH_GPREL":                         "1.19",
+	"debug/elf.R_LARCH_SOP_PUSH_PCREL":                         "1.19",
+	"debug/elf.R_LARCH_SOP_PUSH_PLT_PCREL":                     "1.19",
+	"debug/elf.R_LARCH_SOP_PUSH_TLS_GD":                        "1.19",
+	"debug/elf.R_LARCH_SOP_PUSH_TLS_GOT":                       "1.19",
+	"debug/elf.R_LARCH_SOP_PUSH_TLS_TPREL":                     "1.19",
+	"debug/elf.R_LARCH_SOP_SL":                                 "1.19",
+	"debug/elf.R_LARCH_SOP_SR":                                 "1.19",
+	"debug/elf.R_LARCH_SOP_SUB":                                "1.19",
+	"debug/elf.R_LARCH_SUB16":                                  "1.19",
+	"debug/elf.R_LARCH_SUB24":                                  "1.19",
+	"debug/elf.R_LARCH_SUB32":                                  "1.19",
+	"debug/elf.R_LARCH_SUB6":                                   "1.22",
+	"debug/elf.R_LARCH_SUB64":                                  "1.19",
+	"debug/elf.R_LARCH_SUB8":                                   "1.19",
+	"debug/elf.R_LARCH_SUB_ULEB128":                            "1.22",
+	"debug/elf.R_LARCH_TLS_DESC32":                             "1.26",
+	"debug/elf.R_LARCH_TLS_DESC64":                             "1.26",
+	"debug/elf.R_LARCH_TLS_DESC64_HI12":                        "1.26",
+	"debug/elf.R_LARCH_TLS_DESC64_LO20":                        "1.26",
+	"debug/elf.R_LARCH_TLS_DESC64_PC_HI12":                     "1.26",
+	"debug/elf.R_LARCH_TLS_DESC64_PC_LO20":                     "1.26",
+	"debug/elf.R_LARCH_TLS_DESC_CALL":                          "1.26",
+	"debug/elf.R_LARCH_TLS_DESC_HI20":                          "1.26",
+	"debug/elf.R_LARCH_TLS_DESC_LD":                            "1.26",
+	"debug/elf.R_LARCH_TLS_DESC_LO12":                          "1.26",
+	"debug/elf.R_LARCH_TLS_DESC_PCREL20_S2":                    "1.26",
+	"debug/elf.R_LARCH_TLS_DESC_PC_HI20":                       "1.26",
+	"debug/elf.R_LARCH_TLS_DESC_PC_LO12":                       "1.26",
+	"debug/elf.R_LARCH_TLS_DTPMOD32":                           "1.19",
+	"debug/elf.R_LARCH_TLS_DTPMOD64":                           "1.19",
+	"debug/elf.R_LARCH_TLS_DTPREL32":                           "1.19",
+	"debug/elf.R_LARCH_TLS_DTPREL64":                           "1.19",
+	"debug/elf.R_LARCH_TLS_GD_HI20":                            "1.20",
+	"debug/elf.R_LARCH_TLS_GD_PCREL20_S2":                      "1.26",
+	"debug/elf.R_LARCH_TLS_GD_PC_HI20":                         "1.20",
+	"debug/elf.R_LARCH_TLS_IE64_HI12":                          "1.20",
+	"debug/elf.R_LARCH_TLS_IE64_LO20":                          "1.20",
+	"debug/elf.R_LARCH_TLS_IE64_PC_HI12":                       "1.20",
+	"debug/elf.R_LARCH_TLS_IE64_PC_LO20":                       "1.20",
+	"debug/elf.R_LARCH_TLS_IE_HI20":                            "1.20",
+	"debug/elf.R_LARCH_TLS_IE_LO12":                            "1.20",
+	"debug/elf.R_LARCH_TLS_IE_PC_HI20":                         "1.20",
+	"debug/elf.R_LARCH_TLS_IE_PC_LO12":                         "1.20",
+	"debug/elf.R_LARCH_TLS_LD_HI20":                            "1.20",
+	"debug/elf.R_LARCH_TLS_LD_PCREL20_S2":                      "1.26",
+	"debug/elf.R_LARCH_TLS_LD_PC_HI20":                         "1.20",
+	"debug/elf.R_LARCH_TLS_LE64_HI12":                          "1.20",
+	"debug/elf.R_LARCH_TLS_LE64_LO20":                          "1.20",
+	"debug/elf.R_LARCH_TLS_LE_ADD_R":                           "1.26",
+	"debug/elf.R_LARCH_TLS_LE_HI20":                            "1.20",
+	"debug/elf.R_LARCH_TLS_LE_HI20_R":                          "1.26",
+	"debug/elf.R_LARCH_TLS_LE_LO12":                            "1.20",
+	"debug/elf.R_LARCH_TLS_LE_LO12_R":                          "1.26",
+	"debug/elf.R_LARCH_TLS_TPREL32":                            "1.19",
+	"debug/elf.R_LARCH_TLS_TPREL64":                            "1.19",
+	"debug/elf.R_MIPS":                                         "1.6",
+	"debug/elf.R_MIPS_16":                                      "1.6",
+	"debug/elf.R_MIPS_26":                                      "1.6",
+	"debug/elf.R_MIPS_32":                                      "1.6",
+	"debug/elf.R_MIPS_64":                                      "1.6",
+	"debug/elf.R_MIPS_ADD_IMMEDIATE":                           "1.6",
+	"debug/elf.R_MIPS_CALL16":                                  "1.6",
+	"debug/elf.R_MIPS_CALL_HI16":                               "1.6",
+	"debug/elf.R_MIPS_CALL_LO16":                               "1.6",
+	"debug/elf.R_MIPS_DELETE":                                  "1.6",
+	"debug/elf.R_MIPS_GOT16":                                   "1.6",
+	"debug/elf.R_MIPS_GOT_DISP":                                "1.6",
+	"debug/elf.R_MIPS_GOT_HI16":                                "1.6",
+	"debug/elf.R_MIPS_GOT_LO16":                                "1.6",
+	"debug/elf.R_MIPS_GOT_OFST":                                "1.6",
+	"debug/elf.R_MIPS_GOT_PAGE":                                "1.6",
+	"debug/elf.R_MIPS_GPREL16":                                 "1.6",
+	"debug/elf.R_MIPS_GPREL32":                                 "1.6",
+	"debug/elf.R_MIPS_HI16":                                    "1.6",
+	"debug/elf.R_MIPS_HIGHER":                                  "1.6",
+	"debug/elf.R_MIPS_HIGHEST":                                 "1.6",
+	"debug/elf.R_MIPS_INSERT_A":                                "1.6",
+	"debug/elf.R_MIPS_INSERT_B":                                "1.6",
+	"debug/elf.R_MIPS_JALR":                                    "1.6",
+	"debug/elf.R_MIPS_LITERAL":                                 "1.6",
+	"debug/elf.R_MIPS_LO16":                                    "1.6",
+	"debug/elf.R_MIPS_NONE":                                    "1.6",
+	"debug/elf.R_MIPS_PC16":                                    "1.6",
+	"debug/elf.R_MIPS_PC32":                                    "1.22",
+	"debug/elf.R_MIPS_PJUMP":                                   "1.6",
+	"debug/elf.R_MIPS_REL16":                                   "1.6",
+	"debug/elf.R_MIPS_REL32":                                   "1.6",
+	"debug/elf.R_MIPS_RELGOT":                                  "1.6",
+	"debug/elf.R_MIPS_SCN_DISP":                                "1.6",
+	"debug/elf.R_MIPS_SHIFT5":                                  "1.6",
+	"debug/elf.R_MIPS_SHIFT6":                                  "1.6",
+	"debug/elf.R_MIPS_SUB":                                     "1.6",
+	"debug/elf.R_MIPS_TLS_DTPMOD32":                            "1.6",
+	"debug/elf.R_MIPS_TLS_DTPMOD64":                            "1.6",
+	"debug/elf.R_MIPS_TLS_DTPREL32":                            "1.6",
+	"debug/elf.R_MIPS_TLS_DTPREL64":                            "1.6",
+	"debug/elf.R_MIPS_TLS_DTPREL_HI16":                         "1.6",
+	"debug/elf.R_MIPS_TLS_DTPREL_LO16":                         "1.6",
+	"debug/elf.R_MIPS_TLS_GD":                                  "1.6",
+	"debug/elf.R_MIPS_TLS_GOTTPREL":                            "1.6",
+	"debug/elf.R_MIPS_TLS_LDM":                                 "1.6",
+	"debug/elf.R_MIPS_TLS_TPREL32":                             "1.6",
+	"debug/elf.R_MIPS_TLS_TPREL64":                             "1.6",
+	"debug/elf.R_MIPS_TLS_TPREL_HI16":                          "1.6",
+	"debug/elf.R_MIPS_TLS_TPREL_LO16":                          "1.6",
+	"debug/elf.R_PPC64":                                        "1.5",
+	"debug/elf.R_PPC64_ADDR14":                                 "1.5",
+	"debug/elf.R_PPC64_ADDR14_BRNTAKEN":                        "1.5",
+	"debug/elf.R_PPC64_ADDR14_BRTAKEN":                         "1.5",
+	"debug/elf.R_PPC64_ADDR16":                                 "1.5",
+	"debug/elf.R_PPC64_ADDR16_DS":                              "1.5",
+	"debug/elf.R_PPC64_ADDR16_HA":                              "1.5",
+	"debug/elf.R_PPC64_ADDR16_HI":                              "1.5",
+	"debug/elf.R_PPC64_ADDR16_HIGH":                            "1.10",
+	"debug/elf.R_PPC64_ADDR16_HIGHA":                           "1.10",
+	"debug/elf.R_PPC64_ADDR16_HIGHER":                          "1.5",
+	"debug/elf.R_PPC64_ADDR16_HIGHER34":                        "1.20",
+	"debug/elf.R_PPC64_ADDR16_HIGHERA":                         "1.5",
+	"debug/elf.R_PPC64_ADDR16_HIGHERA34":                       "1.20",
+	"debug/elf.R_PPC64_ADDR16_HIGHEST":                         "1.5",
+	"debug/elf.R_PPC64_ADDR16_HIGHEST34":                       "1.20",
+	"debug/elf.R_PPC64_ADDR16_HIGHESTA":                        "1.5",
+	"debug/elf.R_PPC64_ADDR16_HIGHESTA34":                      "1.20",
+	"debug/elf.R_PPC64_ADDR16_LO":                              "1.5",
+	"debug/elf.R_PPC64_ADDR16_LO_DS":                           "1.5",
+	"debug/elf.R_PPC64_ADDR24":                                 "1.5",
+	"debug/elf.R_PPC64_ADDR32":                                 "1.5",
+	"debug/elf.R_PPC64_ADDR64":                                 "1.5",
+	"debug/elf.R_PPC64_ADDR64_LOCAL":                           "1.10",
+	"debug/elf.R_PPC64_COPY":                                   "1.20",
+	"debug/elf.R_PPC64_D28":                                    "1.20",
+	"debug/elf.R_PPC64_D34":                                    "1.20",
+	"debug/elf.R_PPC64_D34_HA30":                               "1.20",
+	"debug/elf.R_PPC64_D34_HI30":                               "1.20",
+	"debug/elf.R_PPC64_D34_LO":                                 "1.20",
+	"debug/elf.R_PPC64_DTPMOD64":                               "1.5",
+	"debug/elf.R_PPC64_DTPREL16":                               "1.5",
+	"debug/elf.R_PPC64_DTPREL16_DS":                            "1.5",
+	"debug/elf.R_PPC64_DTPREL16_HA":                            "1.5",
+	"debug/elf.R_PPC64_DTPREL16_HI":                            "1.5",
+	"debug/elf.R_PPC64_DTPREL16_HIGH":                          "1.10",
+	"debug/elf.R_PPC64_DTPREL16_HIGHA":                         "1.10",
+	"debug/elf.R_PPC64_DTPREL16_HIGHER":                        "1.5",
+	"debug/elf.R_PPC64_DTPREL16_HIGHERA":                       "1.5",
+	"debug/elf.R_PPC64_DTPREL16_HIGHEST":                       "1.5",
+	"debug/elf.R_PPC64_DTPREL16_HIGHESTA":                      "1.5",
+	"debug/elf.R_PPC64_DTPREL16_LO":                            "1.5",
+	"debug/elf.R_PPC64_DTPREL16_LO_DS":                         "1.5",
+	"debug/elf.R_PPC64_DTPREL34":                               "1.20",
+	"debug/elf.R_PPC64_DTPREL64":                               "1.5",
+	"debug/elf.R_PPC64_ENTRY":                                  "1.10",
+	"debug/elf.R_PPC64_GLOB_DAT":                               "1.20",
+	"debug/elf.R_PPC64_GNU_VTENTRY":                            "1.20",
+	"debug/elf.R_PPC64_GNU_VTINHERIT":                          "1.20",
+	"debug/elf.R_PPC64_GOT16":                                  "1.5",
+	"debug/elf.R_PPC64_GOT16_DS":                               "1.5",
+	"debug/elf.R_PPC64_GOT16_HA":                               "1.5",
+	"debug/elf.R_PPC64_GOT16_HI":                               "1.5",
+	"debug/elf.R_PPC64_GOT16_LO":                               "1.5",
+	"debug/elf.R_PPC64_GOT16_LO_DS":                            "1.5",
+	"debug/elf.R_PPC64_GOT_DTPREL16_DS":                        "1.5",
+	"debug/elf.R_PPC64_GOT_DTPREL16_HA":                        "1.5",
+	"debug/elf.R_PPC64_GOT_DTPREL16_HI":                        "1.5",
+	"debug/elf.R_PPC64_GOT_DTPREL16_LO_DS":                     "1.5",
+	"debug/elf.R_PPC64_GOT_DTPREL_PCREL34":                     "1.20",
+	"debug/elf.R_PPC64_GOT_PCREL34":                            "1.20",
+	"debug/elf.R_PPC64_GOT_TLSGD16":                            "1.5",
+	"debug/elf.R_PPC64_GOT_TLSGD16_HA":                         "1.5",
+	"debug/elf.R_PPC64_GOT_TLSGD16_HI":                         "1.5",
+	"debug/elf.R_PPC64_GOT_TLSGD16_LO":                         "1.5",
+	"debug/elf.R_PPC64_GOT_TLSGD_PCREL34":                      "1.20",
+	"debug/elf.R_PPC64_GOT_TLSLD16":                            "1.5",
+	"debug/elf.R_PPC64_GOT_TLSLD16_HA":                         "1.5",
+	"debug/elf.R_PPC64_GOT_TLSLD16_HI":                         "1.5",
+	"debug/elf.R_PPC64_GOT_TLSLD16_LO":                         "1.5",
+	"debug/elf.R_PPC64_GOT_TLSLD_PCREL34":                      "1.20",
+	"debug/elf.R_PPC64_GOT_TPREL16_DS":                         "1.5",
+	"debug/elf.R_PPC64_GOT_TPREL16_HA":                         "1.5",
+	"debug/elf.R_PPC64_GOT_TPREL16_HI":                         "1.5",
+	"debug/elf.R_PPC64_GOT_TPREL16_LO_DS":                      "1.5",
+	"debug/elf.R_PPC64_GOT_TPREL_PCREL34":                      "1.20",
+	"debug/elf.R_PPC64_IRELATIVE":                              "1.10",
+	"debug/elf.R_PPC64_JMP_IREL":                               "1.10",
+	"debug/elf.R_PPC64_JMP_SLOT":                               "1.5",
+	"debug/elf.R_PPC64_NONE":                                   "1.5",
+	"debug/elf.R_PPC64_PCREL28":                                "1.20",
+	"debug/elf.R_PPC64_PCREL34":                                "1.20",
+	"debug/elf.R_PPC64_PCREL_OPT":                              "1.20",
+	"debug/elf.R_PPC64_PLT16_HA":                               "1.20",
+	"debug/elf.R_PPC64_PLT16_HI":                               "1.20",
+	"debug/elf.R_PPC64_PLT16_LO":                               "1.20",
+	"debug/elf.R_PPC64_PLT16_LO_DS":                            "1.10",
+	"debug/elf.R_PPC64_PLT32":                                  "1.20",
+	"debug/elf.R_PPC64_PLT64":                                  "1.20",
+	"debug/elf.R_PPC64_PLTCALL":                                "1.20",
+	"debug/elf.R_PPC64_PLTCALL_NOTOC":                          "1.20",
+	"debug/elf.R_PPC64_PLTGOT16":                               "1.10",
+	"debug/elf.R_PPC64_PLTGOT16_DS":                            "1.10",
+	"debug/elf.R_PPC64_PLTGOT16_HA":                            "1.10",
+	"debug/elf.R_PPC64_PLTGOT16_HI":                            "1.10",
+	"debug/elf.R_PPC64_PLTGOT16_LO":                            "1.10",
+	"debug/elf.R_PPC64_PLTGOT_LO_DS":                           "1.10",
+	"debug/elf.R_PPC64_PLTREL32":                               "1.20",
+	"debug/elf.R_PPC64_PLTREL64":                               "1.20",
+	"debug/elf.R_PPC64_PLTSEQ":                                 "1.20",
+	"debug/elf.R_PPC64_PLTSEQ_NOTOC":                           "1.20",
+	"debug/elf.R_PPC64_PLT_PCREL34":                            "1.20",
+	"debug/elf.R_PPC64_PLT_PCREL34_NOTOC":                      "1.20",
+	"debug/elf.R_PPC64_REL14":                                  "1.5",
+	"debug/elf.R_PPC64_REL14_BRNTAKEN":                         "1.5",
+	"debug/elf.R_PPC64_REL14_BRTAKEN":                          "1.5",
+	"debug/elf.R_PPC64_REL16":                                  "1.5",
+	"debug/elf.R_PPC64_REL16DX_HA":                             "1.10",
+	"debug/elf.R_PPC64_REL16_HA":                               "1.5",
+	"debug/elf.R_PPC64_REL16_HI":                               "1.5",
+	"debug/elf.R_PPC64_REL16_HIGH":                             "1.20",
+	"debug/elf.R_PPC64_REL16_HIGHA":                            "1.20",
+	"debug/elf.R_PPC64_REL16_HIGHER":                           "1.20",
+	"debug/elf.R_PPC64_REL16_HIGHER34":                         "1.20",
+	"debug/elf.R_PPC64_REL16_HIGHERA":                          "1.20",
+	"debug/elf.R_PPC64_REL16_HIGHERA34":                        "1.20",
+	"debug/elf.R_PPC64_REL16_HIGHEST":                          "1.20",
+	"debug/elf.R_PPC64_REL16_HIGHEST34":                        "1.20",
+	"debug/elf.R_PPC64_REL16_HIGHESTA":                         "1.20",
+	"debug/elf.R_PPC64_REL16_HIGHESTA34":                       "1.20",
+	"debug/elf.R_PPC64_REL16_LO":                               "1.5",
+	"debug/elf.R_PPC64_REL24":                                  "1.5",
+	"debug/elf.R_PPC64_REL24_NOTOC":                            "1.10",
+	"debug/elf.R_PPC64_REL24_P9NOTOC":                          "1.21",
+	"debug/elf.R_PPC64_REL30":                                  "1.20",
+	"debug/elf.R_PPC64_REL32":                                  "1.5",
+	"debug/elf.R_PPC64_REL64":                                  "1.5",
+	"debug/elf.R_PPC64_RELATIVE":                               "1.18",
+	"debug/elf.R_PPC64_SECTOFF":                                "1.20",
+	"debug/elf.R_PPC64_SECTOFF_DS":                             "1.10",
+	"debug/elf.R_PPC64_SECTOFF_HA":                             "1.20",
+	"debug/elf.R_PPC64_SECTOFF_HI":                             "1.20",
+	"debug/elf.R_PPC64_SECTOFF_LO":                             "1.20",
+	"debug/elf.R_PPC64_SECTOFF_LO_DS":                          "1.10",
+	"debug/elf.R_PPC64_TLS":                                    "1.5",
+	"debug/elf.R_PPC64_TLSGD":                                  "1.5",
+	"debug/elf.R_PPC64_TLSLD":                                  "1.5",
+	"debug/elf.R_PPC64_TOC":                                    "1.5",
+	"debug/elf.R_PPC64_TOC16":                                  "1.5",
+	"debug/elf.R_PPC64_TOC16_DS":                               "1.5",
+	"debug/elf.R_PPC64_TOC16_HA":                               "1.5",
+	"debug/elf.R_PPC64_TOC16_HI":                               "1.5",
+	"debug/elf.R_PPC64_TOC16_LO":                               "1.5",
+	"debug/elf.R_PPC64_TOC16_LO_DS":                            "1.5",
+	"debug/elf.R_PPC64_TOCSAVE":                                "1.10",
+	"debug/elf.R_PPC64_TPREL16":                                "1.5",
+	"debug/elf.R_PPC64_TPREL16_DS":                             "1.5",
+	"debug/elf.R_PPC64_TPREL16_HA":                             "1.5",
+	"debug/elf.R_PPC64_TPREL16_HI":                             "1.5",
+	"debug/elf.R_PPC64_TPREL16_HIGH":                           "1.10",
+	"debug/elf.R_PPC64_TPREL16_HIGHA":                          "1.10",
+	"debug/elf.R_PPC64_TPREL16_HIGHER":                         "1.5",
+	"debug/elf.R_PPC64_TPREL16_HIGHERA":                        "1.5",
+	"debug/elf.R_PPC64_TPREL16_HIGHEST":                        "1.5",
+	"debug/elf.R_PPC64_TPREL16_HIGHESTA":                       "1.5",
+	"debug/elf.R_PPC64_TPREL16_LO":                             "1.5",
+	"debug/elf.R_PPC64_TPREL16_LO_DS":                          "1.5",
+	"debug/elf.R_PPC64_TPREL34":                                "1.20",
+	"debug/elf.R_PPC64_TPREL64":                                "1.5",
+	"debug/elf.R_PPC64_UADDR16":                                "1.20",
+	"debug/elf.R_PPC64_UADDR32":                                "1.20",
+	"debug/elf.R_PPC64_UADDR64":                                "1.20",
+	"debug/elf.R_RISCV":                                        "1.11",
+	"debug/elf.R_RISCV_32":                                     "1.11",
+	"debug/elf.R_RISCV_32_PCREL":                               "1.12",
+	"debug/elf.R_RISCV_64":                                     "1.11",
+	"debug/elf.R_RISCV_ADD16":                                  "1.11",
+	"debug/elf.R_RISCV_ADD32":                                  "1.11",
+	"debug/elf.R_RISCV_ADD64":                                  "1.11",
+	"debug/elf.R_RISCV_ADD8":                                   "1.11",
+	"debug/elf.R_RISCV_ALIGN":                                  "1.11",
+	"debug/elf.R_RISCV_BRANCH":                                 "1.11",
+	"debug/elf.R_RISCV_CALL":                                   "1.11",
+	"debug/elf.R_RISCV_CALL_PLT":                               "1.11",
+	"debug/elf.R_RISCV_COPY":                                   "1.11",
+	"debug/elf.R_RISCV_GNU_VTENTRY":                            "1.11",
+	"debug/elf.R_RISCV_GNU_VTINHERIT":                          "1.11",
+	"debug/elf.R_RISCV_GOT_HI20":                               "1.11",
+	"debug/elf.R_RISCV_GPREL_I":                                "1.11",
+	"debug/elf.R_RISCV_GPREL_S":                                "1.11",
+	"debug/elf.R_RISCV_HI20":                                   "1.11",
+	"debug/elf.R_RISCV_JAL":                                    "1.11",
+	"debug/elf.R_RISCV_JUMP_SLOT":                              "1.11",
+	"debug/elf.R_RISCV_LO12_I":                                 "1.11",
+	"debug/elf.R_RISCV_LO12_S":                                 "1.11",
+	"debug/elf.R_RISCV_NONE":                                   "1.11",
+	"debug/elf.R_RISCV_PCREL_HI20":                             "1.11",
+	"debug/elf.R_RISCV_PCREL_LO12_I":                           "1.11",
+	"debug/elf.R_RISCV_PCREL_LO12_S":                           "1.11",
+	"debug/elf.R_RISCV_RELATIVE":                               "1.11",
+	"debug/elf.R_RISCV_RELAX":                                  "1.11",
+	"debug/elf.R_RISCV_RVC_BRANCH":                             "1.11",
+	"debug/elf.R_RISCV_RVC_JUMP":                               "1.11",
+	"debug/elf.R_RISCV_RVC_LUI":                                "1.11",
+	"debug/elf.R_RISCV_SET16":                                  "1.11",
+	"debug/elf.R_RISCV_SET32":                                  "1.11",
+	"debug/elf.R_RISCV_SET6":                                   "1.11",
+	"debug/elf.R_RISCV_SET8":                                   "1.11",
+	"debug/elf.R_RISCV_SUB16":                                  "1.11",
+	"debug/elf.R_RISCV_SUB32":                                  "1.11",
+	"debug/elf.R_RISCV_SUB6":                                   "1.11",
+	"debug/elf.R_RISCV_SUB64":                                  "1.11",
+	"debug/elf.R_RISCV_SUB8":                                   "1.11",
+	"debug/elf.R_RISCV_TLS_DTPMOD32":                           "1.11",
+	"debug/elf.R_RISCV_TLS_DTPMOD64":                           "1.11",
+	"debug/elf.R_RISCV_TLS_DTPREL32":                           "1.11",
+	"debug/elf.R_RISCV_TLS_DTPREL64":                           "1.11",
+	"debug/elf.R_RISCV_TLS_GD_HI20":                            "1.11",
+	"debug/elf.R_RISCV_TLS_GOT_HI20":                           "1.11",
+	"debug/elf.R_RISCV_TLS_TPREL32":                            "1.11",
+	"debug/elf.R_RISCV_TLS_TPREL64":                            "1.11",
+	"debug/elf.R_RISCV_TPREL_ADD":                              "1.11",
+	"debug/elf.R_RISCV_TPREL_HI20":                             "1.11",
+	"debug/elf.R_RISCV_TPREL_I":                                "1.11",
+	"debug/elf.R_RISCV_TPREL_LO12_I":                           "1.11",
+	"debug/elf.R_RISCV_TPREL_LO12_S":                           "1.11",
+	"debug/elf.R_RISCV_TPREL_S":                                "1.11",
+	"debug/elf.R_X86_64_GOT64":                                 "1.10",
+	"debug/elf.R_X86_64_GOTOFF64":                              "1.10",
+	"debug/elf.R_X86_64_GOTPC32":                               "1.10",
+	"debug/elf.R_X86_64_GOTPC32_TLSDESC":                       "1.10",
+	"debug/elf.R_X86_64_GOTPC64":                               "1.10",
+	"debug/elf.R_X86_64_GOTPCREL64":                            "1.10",
+	"debug/elf.R_X86_64_GOTPCRELX":                             "1.10",
+	"debug/elf.R_X86_64_GOTPLT64":                              "1.10",
+	"debug/elf.R_X86_64_IRELATIVE":                             "1.10",
+	"debug/elf.R_X86_64_PC32_BND":                              "1.10",
+	"debug/elf.R_X86_64_PC64":                                  "1.10",
+	"debug/elf.R_X86_64_PLT32_BND":                             "1.10",
+	"debug/elf.R_X86_64_PLTOFF64":                              "1.10",
+	"debug/elf.R_X86_64_RELATIVE64":                            "1.10",
+	"debug/elf.R_X86_64_REX_GOTPCRELX":                         "1.10",
+	"debug/elf.R_X86_64_SIZE32":                                "1.10",
+	"debug/elf.R_X86_64_SIZE64":                                "1.10",
+	"debug/elf.R_X86_64_TLSDESC":                               "1.10",
+	"debug/elf.R_X86_64_TLSDESC_CALL":                          "1.10",
+	"debug/elf.SHF_COMPRESSED":                                 "1.6",
+	"debug/elf.SHT_MIPS_ABIFLAGS":                              "1.17",
+	"debug/elf.SHT_RISCV_ATTRIBUTES":                           "1.25",
+	"debug/elf.STT_GNU_IFUNC":                                  "1.23",
+	"debug/elf.STT_RELC":                                       "1.23",
+	"debug/elf.STT_SRELC":                                      "1.23",
+	"debug/elf.VER_FLG_BASE":                                   "1.24",
+	"debug/elf.VER_FLG_INFO":                                   "1.24",
+	"debug/elf.VER_FLG_WEAK":                                   "1.24",
+	"debug/elf.VersionIndex":                                   "1.24",
+	"debug/macho.ARM64_RELOC_ADDEND":                           "1.10",
+	"debug/macho.ARM64_RELOC_BRANCH26":                         "1.10",
+	"debug/macho.ARM64_RELOC_GOT_LOAD_PAGE21":                  "1.10",
+	"debug/macho.ARM64_RELOC_GOT_LOAD_PAGEOFF12":               "1.10",
+	"debug/macho.ARM64_RELOC_PAGE21":                           "1.10",
+	"debug/macho.ARM64_RELOC_PAGEOFF12":                        "1.10",
+	"debug/macho.ARM64_RELOC_POINTER_TO_GOT":                   "1.10",
+	"debug/macho.ARM64_RELOC_SUBTRACTOR":                       "1.10",
+	"debug/macho.ARM64_RELOC_TLVP_LOAD_PAGE21":                 "1.10",
+	"debug/macho.ARM64_RELOC_TLVP_LOAD_PAGEOFF12":              "1.10",
+	"debug/macho.ARM64_RELOC_UNSIGNED":                         "1.10",
+	"debug/macho.ARM_RELOC_BR24":                               "1.10",
+	"debug/macho.ARM_RELOC_HALF":                               "1.10",
+	"debug/macho.ARM_RELOC_HALF_SECTDIFF":                      "1.10",
+	"debug/macho.ARM_RELOC_LOCAL_SECTDIFF":                     "1.10",
+	"debug/macho.ARM_RELOC_PAIR":                               "1.10",
+	"debug/macho.ARM_RELOC_PB_LA_PTR":                          "1.10",
+	"debug/macho.ARM_RELOC_SECTDIFF":                           "1.10",
+	"debug/macho.ARM_RELOC_VANILLA":                            "1.10",
+	"debug/macho.ARM_THUMB_32BIT_BRANCH":                       "1.10",
+	"debug/macho.ARM_THUMB_RELOC_BR22":                         "1.10",
+	"debug/macho.CpuArm":                                       "1.3",
+	"debug/macho.CpuArm64":                                     "1.11",
+	"debug/macho.CpuPpc":                                       "1.3",
+	"debug/macho.CpuPpc64":                                     "1.3",
+	"debug/macho.ErrNotFat":                                    "1.3",
+	"debug/macho.FatArch":                                      "1.3",
+	"debug/macho.FatArchHeader":                                "1.3",
+	"debug/macho.FatFile":                                      "1.3",
+	"debug/macho.FlagAllModsBound":                             "1.10",
+	"debug/macho.FlagAllowStackExecution":                      "1.10",
+	"debug/macho.FlagAppExtensionSafe":                         "1.10",
+	"debug/macho.FlagBindAtLoad":                               "1.10",
+	"debug/macho.FlagBindsToWeak":                              "1.10",
+	"debug/macho.FlagCanonical":                                "1.10",
+	"debug/macho.FlagDeadStrippableDylib":                      "1.10",
+	"debug/macho.FlagDyldLink":                                 "1.10",
+	"debug/macho.FlagForceFlat":                                "1.10",
+	"debug/macho.FlagHasTLVDescriptors":                        "1.10",
+	"debug/macho.FlagIncrLink":                                 "1.10",
+	"debug/macho.FlagLazyInit":                                 "1.10",
+	"debug/macho.FlagNoFixPrebinding":                          "1.10",
+	"debug/macho.FlagNoHeapExecution":                          "1.10",
+	"debug/macho.FlagNoMultiDefs":                              "1.10",
+	"debug/macho.FlagNoReexportedDylibs":                       "1.10",
+	"debug/macho.FlagNoUndefs":                                 "1.10",
+	"debug/macho.FlagPIE":                                      "1.10",
+	"debug/macho.FlagPrebindable":                              "1.10",
+	"debug/macho.FlagPrebound":                                 "1.10",
+	"debug/macho.FlagRootSafe":                                 "1.10",
+	"debug/macho.FlagSetuidSafe":                               "1.10",
+	"debug/macho.FlagSplitSegs":                                "1.10",
+	"debug/macho.FlagSubsectionsViaSymbols":                    "1.10",
+	"debug/macho.FlagTwoLevel":                                 "1.10",
+	"debug/macho.FlagWeakDefines":                              "1.10",
+	"debug/macho.GENERIC_RELOC_LOCAL_SECTDIFF":                 "1.10",
+	"debug/macho.GENERIC_RELOC_PAIR":                           "1.10",
+	"debug/macho.GENERIC_RELOC_PB_LA_PTR":                      "1.10",
+	"debug/macho.GENERIC_RELOC_SECTDIFF":                       "1.10",
+	"debug/macho.GENERIC_RELOC_TLV":                            "1.10",
+	"debug/macho.GENERIC_RELOC_VANILLA":                        "1.10",
+	"debug/macho.LoadCmdRpath":                                 "1.10",
+	"debug/macho.MagicFat":                                     "1.3",
+	"debug/macho.NewFatFile":                                   "1.3",
+	"debug/macho.OpenFat":                                      "1.3",
+	"debug/macho.Reloc":                                        "1.10",
+	"debug/macho.RelocTypeARM":                                 "1.10",
+	"debug/macho.RelocTypeARM64":                               "1.10",
+	"debug/macho.RelocTypeGeneric":                             "1.10",
+	"debug/macho.RelocTypeX86_64":                              "1.10",
+	"debug/macho.Rpath":                                        "1.10",
+	"debug/macho.RpathCmd":                                     "1.10",
+	"debug/macho.TypeBundle":                                   "1.3",
+	"debug/macho.TypeDylib":                                    "1.3",
+	"debug/macho.X86_64_RELOC_BRANCH":                          "1.10",
+	"debug/macho.X86_64_RELOC_GOT":                             "1.10",
+	"debug/macho.X86_64_RELOC_GOT_LOAD":                        "1.10",
+	"debug/macho.X86_64_RELOC_SIGNED":                          "1.10",
+	"debug/macho.X86_64_RELOC_SIGNED_1":                        "1.10",
+	"debug/macho.X86_64_RELOC_SIGNED_2":                        "1.10",
+	"debug/macho.X86_64_RELOC_SIGNED_4":                        "1.10",
+	"debug/macho.X86_64_RELOC_SUBTRACTOR":                      "1.10",
+	"debug/macho.X86_64_RELOC_TLV":                             "1.10",
+	"debug/macho.X86_64_RELOC_UNSIGNED":                        "1.10",
+	"debug/pe.COFFSymbol":                                      "1.1",
+	"debug/pe.COFFSymbolAuxFormat5":                            "1.19",
+	"debug/pe.COFFSymbolSize":                                  "1.1",
+	"debug/pe.DataDirectory":                                   "1.3",
+	"debug/pe.IMAGE_COMDAT_SELECT_ANY":                         "1.19",
+	"debug/pe.IMAGE_COMDAT_SELECT_ASSOCIATIVE":                 "1.19",
+	"debug/pe.IMAGE_COMDAT_SELECT_EXACT_MATCH":                 "1.19",
+	"debug/pe.IMAGE_COMDAT_SELECT_LARGEST":                     "1.19",
+	"debug/pe.IMAGE_COMDAT_SELECT_NODUPLICATES":                "1.19",
+	"debug/pe.IMAGE_COMDAT_SELECT_SAME_SIZE":                   "1.19",
+	"debug/pe.IMAGE_DIRECTORY_ENTRY_ARCHITECTURE":              "1.11",
+	"debug/pe.IMAGE_DIRECTORY_ENTRY_BASERELOC":                 "1.11",
+	"debug/pe.IMAGE_DIRECTORY_ENTRY_BOUND_IMPORT":              "1.11",
+	"debug/pe.IMAGE_DIRECTORY_ENTRY_COM_DESCRIPTOR":            "1.11",
+	"debug/pe.IMAGE_DIRECTORY_ENTRY_DEBUG":                     "1.11",
+	"debug/pe.IMAGE_DIRECTORY_ENTRY_DELAY_IMPORT":              "1.11",
+	"debug/pe.IMAGE_DIRECTORY_ENTRY_EXCEPTION":                 "1.11",
+	"debug/pe.IMAGE_DIRECTORY_ENTRY_EXPORT":                    "1.11",
+	"debug/pe.IMAGE_DIRECTORY_ENTRY_GLOBALPTR":                 "1.11",
+	"debug/pe.IMAGE_DIRECTORY_ENTRY_IAT":                       "1.11",
+	"debug/pe.IMAGE_DIRECTORY_ENTRY_IMPORT":                    "1.11",
+	"debug/pe.IMAGE_DIRECTORY_ENTRY_LOAD_CONFIG":               "1.11",
+	"debug/pe.IMAGE_DIRECTORY_ENTRY_RESOURCE":                  "1.11",
+	"debug/pe.IMAGE_DIRECTORY_ENTRY_SECURITY":                  "1.11",
+	"debug/pe.IMAGE_DIRECTORY_ENTRY_TLS":                       "1.11",
+	"debug/pe.IMAGE_DLLCHARACTERISTICS_APPCONTAINER":           "1.15",
+	"debug/pe.IMAGE_DLLCHARACTERISTICS_DYNAMIC_BASE":           "1.15",
+	"debug/pe.IMAGE_DLLCHARACTERISTICS_FORCE_INTEGRITY":        "1.15",
+	"debug/pe.IMAGE_DLLCHARACTERISTICS_GUARD_CF":               "1.15",
+	"debug/pe.IMAGE_DLLCHARACTERISTICS_HIGH_ENTROPY_VA":        "1.15",
+	"debug/pe.IMAGE_DLLCHARACTERISTICS_NO_BIND":                "1.15",
+	"debug/pe.IMAGE_DLLCHARACTERISTICS_NO_ISOLATION":           "1.15",
+	"debug/pe.IMAGE_DLLCHARACTERISTICS_NO_SEH":                 "1.15",
+	"debug/pe.IMAGE_DLLCHARACTERISTICS_NX_COMPAT":              "1.15",
+	"debug/pe.IMAGE_DLLCHARACTERISTICS_TERMINAL_SERVER_AWARE":  "1.15",
+	"debug/pe.IMAGE_DLLCHARACTERISTICS_WDM_DRIVER":             "1.15",
+	"debug/pe.IMAGE_FILE_32BIT_MACHINE":                        "1.15",
+	"debug/pe.IMAGE_FILE_AGGRESIVE_WS_TRIM":                    "1.15",
+	"debug/pe.IMAGE_FILE_BYTES_REVERSED_HI":                    "1.15",
+	"debug/pe.IMAGE_FILE_BYTES_REVERSED_LO":                    "1.15",
+	"debug/pe.IMAGE_FILE_DEBUG_STRIPPED":                       "1.15",
+	"debug/pe.IMAGE_FILE_DLL":                                  "1.15",
+	"debug/pe.IMAGE_FILE_EXECUTABLE_IMAGE":                     "1.15",
+	"debug/pe.IMAGE_FILE_LARGE_ADDRESS_AWARE":                  "1.15",
+	"debug/pe.IMAGE_FILE_LINE_NUMS_STRIPPED":                   "1.15",
+	"debug/pe.IMAGE_FILE_LOCAL_SYMS_STRIPPED":                  "1.15",
+	"debug/pe.IMAGE_FILE_MACHINE_ARM64":                        "1.11",
+	"debug/pe.IMAGE_FILE_MACHINE_ARMNT":                        "1.12",
+	"debug/pe.IMAGE_FILE_MACHINE_LOONGARCH32":                  "1.19",
+	"debug/pe.IMAGE_FILE_MACHINE_LOONGARCH64":                  "1.19",
+	"debug/pe.IMAGE_FILE_MACHINE_RISCV128":                     "1.20",
+	"debug/pe.IMAGE_FILE_MACHINE_RISCV32":                      "1.20",
+	"debug/pe.IMAGE_FILE_MACHINE_RISCV64":                      "1.20",
+	"debug/pe.IMAGE_FILE_NET_RUN_FROM_SWAP":                    "1.15",
+	"debug/pe.IMAGE_FILE_RELOCS_STRIPPED":                      "1.15",
+	"debug/pe.IMAGE_FILE_REMOVABLE_RUN_FROM_SWAP":              "1.15",
+	"debug/pe.IMAGE_FILE_SYSTEM":                               "1.15",
+	"debug/pe.IMAGE_FILE_UP_SYSTEM_ONLY":                       "1.15",
+	"debug/pe.IMAGE_SCN_CNT_CODE":                              "1.19",
+	"debug/pe.IMAGE_SCN_CNT_INITIALIZED_DATA":                  "1.19",
+	"debug/pe.IMAGE_SCN_CNT_UNINITIALIZED_DATA":                "1.19",
+	"debug/pe.IMAGE_SCN_LNK_COMDAT":                            "1.19",
+	"debug/pe.IMAGE_SCN_MEM_DISCARDABLE":                       "1.19",
+	"debug/pe.IMAGE_SCN_MEM_EXECUTE":                           "1.19",
+	"debug/pe.IMAGE_SCN_MEM_READ":                              "1.19",
+	"debug/pe.IMAGE_SCN_MEM_WRITE":                             "1.19",
+	"debug/pe.IMAGE_SUBSYSTEM_EFI_APPLICATION":                 "1.15",
+	"debug/pe.IMAGE_SUBSYSTEM_EFI_BOOT_SERVICE_DRIVER":         "1.15",
+	"debug/pe.IMAGE_SUBSYSTEM_EFI_ROM":                         "1.15",
+	"debug/pe.IMAGE_SUBSYSTEM_EFI_RUNTIME_DRIVER":              "1.15",
+	"debug/pe.IMAGE_SUBSYSTEM_NATIVE":                          "1.15",
+	"debug/pe.IMAGE_SUBSYSTEM_NATIVE_WINDOWS":                  "1.15",
+	"debug/pe.IMAGE_SUBSYSTEM_OS2_CUI":                         "1.15",
+	"debug/pe.IMAGE_SUBSYSTEM_POSIX_CUI":                       "1.15",
+	"debug/pe.IMAGE_SUBSYSTEM_UNKNOWN":                         "1.15",
+	"debug/pe.IMAGE_SUBSYSTEM_WINDOWS_BOOT_APPLICATION":        "1.15",
+	"debug/pe.IMAGE_SUBSYSTEM_WINDOWS_CE_GUI":                  "1.15",
+	"debug/pe.IMAGE_SUBSYSTEM_WINDOWS_CUI":                     "1.15",
+	"debug/pe.IMAGE_SUBSYSTEM_WINDOWS_GUI":                     "1.15",
+	"debug/pe.IMAGE_SUBSYSTEM_XBOX":                            "1.15",
+	"debug/pe.OptionalHeader32":                                "1.3",
+	"debug/pe.OptionalHeader64":                                "1.3",
+	"debug/pe.Reloc":                                           "1.8",
+	"debug/pe.StringTable":                                     "1.8",
+	"debug/pe.Symbol":                                          "1.1",
+	"debug/plan9obj.ErrNoSymbols":                              "1.18",
+	"encoding.BinaryAppender":                                  "1.24",
+	"encoding.TextAppender":                                    "1.24",
+	"encoding/asn1.ClassApplication":                           "1.6",
+	"encoding/asn1.ClassContextSpecific":                       "1.6",
+	"encoding/asn1.ClassPrivate":                               "1.6",
+	"encoding/asn1.ClassUniversal":                             "1.6",
+	"encoding/asn1.MarshalWithParams":                          "1.10",
+	"encoding/asn1.NullBytes":                                  "1.9",
+	"encoding/asn1.NullRawValue":                               "1.9",
+	"encoding/asn1.TagBMPString":                               "1.14",
+	"encoding/asn1.TagBitString":                               "1.6",
+	"encoding/asn1.TagBoolean":                                 "1.6",
+	"encoding/asn1.TagEnum":                                    "1.6",
+	"encoding/asn1.TagGeneralString":                           "1.6",
+	"encoding/asn1.TagGeneralizedTime":                         "1.6",
+	"encoding/asn1.TagIA5String":                               "1.6",
+	"encoding/asn1.TagInteger":                                 "1.6",
+	"encoding/asn1.TagNull":                                    "1.9",
+	"encoding/asn1.TagNumericString":                           "1.10",
+	"encoding/asn1.TagOID":                                     "1.6",
+	"encoding/asn1.TagOctetString":                             "1.6",
+	"encoding/asn1.TagPrintableString":                         "1.6",
+	"encoding/asn1.TagSequence":                                "1.6",
+	"encoding/asn1.TagSet":                                     "1.6",
+	"encoding/asn1.TagT61String":                               "1.6",
+	"encoding/asn1.TagUTCTime":                                 "1.6",
+	"encoding/asn1.TagUTF8String":                              "1.6",
+	"encoding/base32.NoPadding":                                "1.9",
+	"encoding/base32.StdPadding":                               "1.9",
+	"encoding/base64.NoPadding":                                "1.5",
+	"encoding/base64.RawStdEncoding":                           "1.5",
+	"encoding/base64.RawURLEncoding":                           "1.5",
+	"encoding/base64.StdPadding":                               "1.5",
+	"encoding/binary.Append":                                   "1.23",
+	"encoding/binary.AppendByteOrder":                          "1.19",
+	"encoding/binary.AppendUvarint":                            "1.19",
+	"encoding/binary.AppendVarint":                             "1.19",
+	"encoding/binary.Decode":                                   "1.23",
+	"encoding/binary.Encode":                                   "1.23",
+	"encoding/binary.NativeEndian":                             "1.21",
+	"encoding/hex.AppendDecode":                                "1.22",
+	"encoding/hex.AppendEncode":                                "1.22",
+	"encoding/hex.NewDecoder":                                  "1.10",
+	"encoding/hex.NewEncoder":                                  "1.10",
+	"encoding/json.CallMethodsWithLegacySemantics":             "1.27",
+	"encoding/json.DefaultOptionsV1":                           "1.27",
+	"encoding/json.Delim":                                      "1.5",
+	"encoding/json.FormatByteArrayAsArray":                     "1.27",
+	"encoding/json.FormatBytesWithLegacySemantics":             "1.27",
+	"encoding/json.FormatDurationAsNano":                       "1.27",
+	"encoding/json.MatchCaseSensitiveDelimiter":                "1.27",
+	"encoding/json.MergeWithLegacySemantics":                   "1.27",
+	"encoding/json.Number":                                     "1.1",
+	"encoding/json.OmitEmptyWithLegacySemantics":               "1.27",
+	"encoding/json.Options":                                    "1.27",
+	"encoding/json.ParseBytesWithLooseRFC4648":                 "1.27",
+	"encoding/json.ParseTimeWithLooseRFC3339":                  "1.27",
+	"encoding/json.ReportErrorsWithLegacySemantics":            "1.27",
+	"encoding/json.StringifyWithLegacySemantics":               "1.27",
+	"encoding/json.Token":                                      "1.5",
+	"encoding/json.UnmarshalArrayFromAnyLength":                "1.27",
+	"encoding/json.Valid":                                      "1.9",
+	"encoding/xml.EscapeText":                                  "1.1",
+	"encoding/xml.Marshaler":                                   "1.2",
+	"encoding/xml.MarshalerAttr":                               "1.2",
+	"encoding/xml.NewTokenDecoder":                             "1.10",
+	"encoding/xml.TokenReader":                                 "1.10",
+	"encoding/xml.Unmarshaler":                                 "1.2",
+	"encoding/xml.UnmarshalerAttr":                             "1.2",
+	"errors.As":                                                "1.13",
+	"errors.AsType":                                            "1.26",
+	"errors.ErrUnsupported":                                    "1.21",
+	"errors.Is":                                                "1.13",
+	"errors.Join":                                              "1.20",
+	"errors.Unwrap":                                            "1.13",
+	"expvar.Handler":                                           "1.8",
+	"flag.BoolFunc":                                            "1.21",
+	"flag.CommandLine":                                         "1.2",
+	"flag.Func":                                                "1.16",
+	"flag.Getter":                                              "1.2",
+	"flag.TextVar":                                             "1.19",
+	"flag.UnquoteUsage":                                        "1.5",
+	"fmt.Append":                                               "1.19",
+	"fmt.Appendf":                                              "1.19",
+	"fmt.Appendln":                                             "1.19",
+	"fmt.FormatString":                                         "1.20",
+	"go/ast.CommentMap":                                        "1.1",
+	"go/ast.Directive":                                         "1.26",
+	"go/ast.DirectiveArg":                                      "1.26",
+	"go/ast.IndexListExpr":                                     "1.18",
+	"go/ast.IsGenerated":                                       "1.21",
+	"go/ast.NewCommentMap":                                     "1.1",
+	"go/ast.ParseDirective":                                    "1.26",
+	"go/ast.Preorder":                                          "1.23",
+	"go/ast.PreorderStack":                                     "1.25",
+	"go/ast.Unparen":                                           "1.22",
+	"go/build.Directive":                                       "1.21",
+	"go/build.IgnoreVendor":                                    "1.6",
+	"go/build.ImportComment":                                   "1.4",
+	"go/build.MultiplePackageError":                            "1.4",
+	"go/build/constraint.GoVersion":                            "1.21",
+	"go/constant.Make":                                         "1.13",
+	"go/constant.StringLen":                                    "1.27",
+	"go/constant.ToComplex":                                    "1.6",
+	"go/constant.ToFloat":                                      "1.6",
+	"go/constant.ToInt":                                        "1.6",
+	"go/constant.Val":                                          "1.13",
+	"go/doc.IllegalPrefixes":                                   "1.1",
+	"go/doc.IsPredeclared":                                     "1.8",
+	"go/doc.NewFromFiles":                                      "1.14",
+	"go/doc.Note":                                              "1.1",
+	"go/doc.PreserveAST":                                       "1.12",
+	"go/importer.ForCompiler":                                  "1.12",
+	"go/parser.AllErrors":                                      "1.1",
+	"go/parser.ParseExprFrom":                                  "1.5",
+	"go/parser.SkipObjectResolution":                           "1.17",
+	"go/token.IsExported":                                      "1.13",
+	"go/token.IsIdentifier":                                    "1.13",
+	"go/token.IsKeyword":                                       "1.13",
+	"go/token.TILDE":                                           "1.18",
+	"go/types.Alias":                                           "1.22",
+	"go/types.ArgumentError":                                   "1.18",
+	"go/types.CheckExpr":                                       "1.13",
+	"go/types.Context":                                         "1.18",
+	"go/types.Default":                                         "1.8",
+	"go/types.FieldVar":                                        "1.25",
+	"go/types.Hasher":                                          "1.27",
+	"go/types.HasherIgnoreTags":                                "1.27",
+	"go/types.IdenticalIgnoreTags":                             "1.8",
+	"go/types.ImportMode":                                      "1.6",
+	"go/types.ImporterFrom":                                    "1.6",
+	"go/types.Instance":                                        "1.18",
+	"go/types.Instantiate":                                     "1.18",
+	"go/types.LocalVar":                                        "1.25",
+	"go/types.LookupSelection":                                 "1.25",
+	"go/types.NewAlias":                                        "1.22",
+	"go/types.NewContext":                                      "1.18",
+	"go/types.NewInterfaceType":                                "1.11",
+	"go/types.NewSignatureType":                                "1.18",
+	"go/types.NewTerm":                                         "1.18",
+	"go/types.NewTypeParam":                                    "1.18",
+	"go/types.NewUnion":                                        "1.18",
+	"go/types.PackageVar":                                      "1.25",
+	"go/types.ParamVar":                                        "1.25",
+	"go/types.RecvVar":                                         "1.25",
+	"go/types.ResultVar":                                       "1.25",
+	"go/types.Satisfies":                                       "1.20",
+	"go/types.SizesFor":                                        "1.9",
+	"go/types.Term":                                            "1.18",
+	"go/types.TypeList":                                        "1.18",
+	"go/types.TypeParam":                                       "1.18",
+	"go/types.TypeParamList":                                   "1.18",
+	"go/types.Unalias":                                         "1.22",
+	"go/types.Union":                                           "1.18",
+	"go/types.VarKind":                                         "1.25",
+	"hash.Cloner":                                              "1.25",
+	"hash.XOF":                                                 "1.25",
+	"hash/fnv.New128":                                          "1.9",
+	"hash/fnv.New128a":                                         "1.9",
+	"hash/maphash.Bytes":                                       "1.19",
+	"hash/maphash.Comparable":                                  "1.24",
+	"hash/maphash.ComparableHasher":                            "1.27",
+	"hash/maphash.Hasher":                                      "1.27",
+	"hash/maphash.String":                                      "1.19",
+	"hash/maphash.WriteComparable":                             "1.24",
+	"html/template.ErrJSTemplate":                              "1.21",
+	"html/template.ErrPredefinedEscaper":                       "1.9",
+	"html/template.IsTrue":                                     "1.6",
+	"html/template.ParseFS":                                    "1.16",
+	"html/template.Srcset":                                     "1.10",
+	"image.CMYK":                                               "1.5",
+	"image.NYCbCrA":                                            "1.6",
+	"image.NewCMYK":                                            "1.5",
+	"image.NewNYCbCrA":                                         "1.6",
+	"image.RGBA64Image":                                        "1.17",
+	"image.YCbCrSubsampleRatio410":                             "1.5",
+	"image.YCbCrSubsampleRatio411":                             "1.5",
+	"image.YCbCrSubsampleRatio440":                             "1.1",
+	"image/color.CMYK":                                         "1.5",
+	"image/color.CMYKModel":                                    "1.5",
+	"image/color.CMYKToRGB":                                    "1.5",
+	"image/color.NYCbCrA":                                      "1.6",
+	"image/color.NYCbCrAModel":                                 "1.6",
+	"image/color.RGBToCMYK":                                    "1.5",
+	"image/draw.Drawer":                                        "1.2",
+	"image/draw.FloydSteinberg":                                "1.2",
+	"image/draw.Quantizer":                                     "1.2",
+	"image/draw.RGBA64Image":                                   "1.17",
+	"image/gif.DisposalBackground":                             "1.5",
+	"image/gif.DisposalNone":                                   "1.5",
+	"image/gif.DisposalPrevious":                               "1.5",
+	"image/gif.Encode":                                         "1.2",
+	"image/gif.EncodeAll":                                      "1.2",
+	"image/gif.Options":                                        "1.2",
+	"image/png.BestCompression":                                "1.4",
+	"image/png.BestSpeed":                                      "1.4",
+	"image/png.CompressionLevel":                               "1.4",
+	"image/png.DefaultCompression":                             "1.4",
+	"image/png.Encoder":                                        "1.4",
+	"image/png.EncoderBuffer":                                  "1.9",
+	"image/png.EncoderBufferPool":                              "1.9",
+	"image/png.NoCompression":                                  "1.4",
+	"io.ByteWriter":                                            "1.1",
+	"io.CopyBuffer":                                            "1.5",
+	"io.Discard":                                               "1.16",
+	"io.ErrNoProgress":                                         "1.1",
+	"io.NewOffsetWriter":                                       "1.20",
+	"io.NopCloser":                                             "1.16",
+	"io.OffsetWriter":                                          "1.20",
+	"io.ReadAll":                                               "1.16",
+	"io.ReadSeekCloser":                                        "1.16",
+	"io.SeekCurrent":                                           "1.7",
+	"io.SeekEnd":                                               "1.7",
+	"io.SeekStart":                                             "1.7",
+	"io.StringWriter":                                          "1.12",
+	"io/fs.FileInfoToDirEntry":                                 "1.17",
+	"io/fs.FormatDirEntry":                                     "1.21",
+	"io/fs.FormatFileInfo":                                     "1.21",
+	"io/fs.Lstat":                                              "1.25",
+	"io/fs.ReadLink":                                           "1.25",
+	"io/fs.ReadLinkFS":                                         "1.25",
+	"io/fs.SkipAll":                                            "1.20",
+	"log.Default":                                              "1.16",
+	"log.LUTC":                                                 "1.5",
+	"log.Lmsgprefix":                                           "1.14",
+	"log.Output":                                               "1.5",
+	"log.Writer":                                               "1.13",
+	"log/slog.DiscardHandler":                                  "1.24",
+	"log/slog.GroupAttrs":                                      "1.25",
+	"log/slog.MultiHandler":                                    "1.26",
+	"log/slog.NewMultiHandler":                                 "1.26",
+	"log/slog.SetLogLoggerLevel":                               "1.22",
+	"log/syslog.LOG_AUTH":                                      "1.1",
+	"log/syslog.LOG_AUTHPRIV":                                  "1.1",
+	"log/syslog.LOG_CRON":                                      "1.1",
+	"log/syslog.LOG_DAEMON":                                    "1.1",
+	"log/syslog.LOG_FTP":                                       "1.1",
+	"log/syslog.LOG_KERN":                                      "1.1",
+	"log/syslog.LOG_LOCAL0":                                    "1.1",
+	"log/syslog.LOG_LOCAL1":                                    "1.1",
+	"log/syslog.LOG_LOCAL2":                                    "1.1",
+	"log/syslog.LOG_LOCAL3":                                    "1.1",
+	"log/syslog.LOG_LOCAL4":                                    "1.1",
+	"log/syslog.LOG_LOCAL5":                                    "1.1",
+	"log/syslog.LOG_LOCAL6":                                    "1.1",
+	"log/syslog.LOG_LOCAL7":                                    "1.1",
+	"log/syslog.LOG_LPR":                                       "1.1",
+	"log/syslog.LOG_MAIL":                                      "1.1",
+	"log/syslog.LOG_NEWS":                                      "1.1",
+	"log/syslog.LOG_SYSLOG":                                    "1.1",
+	"log/syslog.LOG_USER":                                      "1.1",
+	"log/syslog.LOG_UUCP":                                      "1.1",
+	"maps.All":                                                 "1.23",
+	"maps.Collect":                                             "1.23",
+	"maps.Insert":                                              "1.23",
+	"maps.Keys":                                                "1.23",
+	"maps.Values":                                              "1.23",
+	"math.Erfcinv":                                             "1.10",
+	"math.Erfinv":                                              "1.10",
+	"math.FMA":                                                 "1.14",
+	"math.MaxInt":                                              "1.17",
+	"math.MaxUint":                                             "1.17",
+	"math.MinInt":                                              "1.17",
+	"math.Nextafter32":                                         "1.4",
+	"math.Round":                                               "1.10",
+	"math.RoundToEven":                                         "1.10",
+	"math/big.Above":                                           "1.5",
+	"math/big.Accuracy":                                        "1.5",
+	"math/big.AwayFromZero":                                    "1.5",
+	"math/big.Below":                                           "1.5",
+	"math/big.Ceil":                                            "1.27",
+	"math/big.ErrNaN":                                          "1.5",
+	"math/big.Exact":                                           "1.5",
+	"math/big.Float":                                           "1.5",
+	"math/big.Floor":                                           "1.27",
+	"math/big.Jacobi":                                          "1.5",
+	"math/big.MaxExp":                                          "1.5",
+	"math/big.MaxPrec":                                         "1.5",
+	"math/big.MinExp":                                          "1.5",
+	"math/big.NewFloat":                                        "1.5",
+	"math/big.ParseFloat":                                      "1.5",
+	"math/big.Round":                                           "1.27",
+	"math/big.RoundingMode":                                    "1.5",
+	"math/big.ToNearestAway":                                   "1.5",
+	"math/big.ToNearestEven":                                   "1.5",
+	"math/big.ToNegativeInf":                                   "1.5",
+	"math/big.ToPositiveInf":                                   "1.5",
+	"math/big.ToZero":                                          "1.5",
+	"math/big.Trunc":                                           "1.27",
+	"math/bits.Add":                                            "1.12",
+	"math/bits.Add32":                                          "1.12",
+	"math/bits.Add64":                                          "1.12",
+	"math/bits.Div":                                            "1.12",
+	"math/bits.Div32":                                          "1.12",
+	"math/bits.Div64":                                          "1.12",
+	"math/bits.Mul":                                            "1.12",
+	"math/bits.Mul32":                                          "1.12",
+	"math/bits.Mul64":                                          "1.12",
+	"math/bits.Rem":                                            "1.14",
+	"math/bits.Rem32":                                          "1.14",
+	"math/bits.Rem64":                                          "1.14",
+	"math/bits.Sub":                                            "1.12",
+	"math/bits.Sub32":                                          "1.12",
+	"math/bits.Sub64":                                          "1.12",
+	"math/rand.Read":                                           "1.6",
+	"math/rand.Shuffle":                                        "1.10",
+	"math/rand.Source64":                                       "1.8",
+	"math/rand.Uint64":                                         "1.8",
+	"math/rand/v2.Uint":                                        "1.23",
+	"mime.BEncoding":                                           "1.5",
+	"mime.ErrInvalidMediaParameter":                            "1.9",
+	"mime.ExtensionsByType":                                    "1.5",
+	"mime.QEncoding":                                           "1.5",
+	"mime.WordDecoder":                                         "1.5",
+	"mime.WordEncoder":                                         "1.5",
+	"mime/multipart.ErrMessageTooLarge":                        "1.9",
+	"mime/multipart.FileContentDisposition":                    "1.25",
+	"net.Buffers":                                              "1.8",
+	"net.DefaultResolver":                                      "1.8",
+	"net.Dialer":                                               "1.1",
+	"net.ErrClosed":                                            "1.16",
+	"net.FlagRunning":                                          "1.20",
+	"net.KeepAliveConfig":                                      "1.23",
+	"net.ListenConfig":                                         "1.11",
+	"net.LookupNS":                                             "1.1",
+	"net.NS":                                                   "1.1",
+	"net.Resolver":                                             "1.8",
+	"net.TCPAddrFromAddrPort":                                  "1.18",
+	"net.UDPAddrFromAddrPort":                                  "1.18",
+	"net/http.AllowQuerySemicolons":                            "1.17",
+	"net/http.ClientConn":                                      "1.26",
+	"net/http.CloseNotifier":                                   "1.1",
+	"net/http.ConnState":                                       "1.3",
+	"net/http.CrossOriginProtection":                           "1.25",
+	"net/http.DefaultMaxHeaderValueCount":                      "1.27",
+	"net/http.ErrAbortHandler":                                 "1.8",
+	"net/http.ErrSchemeMismatch":                               "1.21",
+	"net/http.ErrServerClosed":                                 "1.8",
+	"net/http.ErrSkipAltProtocol":                              "1.6",
+	"net/http.ErrUseLastResponse":                              "1.7",
+	"net/http.FS":                                              "1.16",
+	"net/http.FileServerFS":                                    "1.22",
+	"net/http.HTTP2Config":                                     "1.24",
+	"net/http.LocalAddrContextKey":                             "1.7",
+	"net/http.MaxBytesError":                                   "1.19",
+	"net/http.MaxBytesHandler":                                 "1.18",
+	"net/http.MethodConnect":                                   "1.6",
+	"net/http.MethodDelete":                                    "1.6",
+	"net/http.MethodGet":                                       "1.6",
+	"net/http.MethodHead":                                      "1.6",
+	"net/http.MethodOptions":                                   "1.6",
+	"net/http.MethodPatch":                                     "1.6",
+	"net/http.MethodPost":                                      "1.6",
+	"net/http.MethodPut":                                       "1.6",
+	"net/http.MethodTrace":                                     "1.6",
+	"net/http.NewCrossOriginProtection":                        "1.25",
+	"net/http.NewFileTransportFS":                              "1.22",
+	"net/http.NewRequestWithContext":                           "1.13",
+	"net/http.NewResponseController":                           "1.20",
+	"net/http.NoBody":                                          "1.8",
+	"net/http.ParseCookie":                                     "1.23",
+	"net/http.ParseSetCookie":                                  "1.23",
+	"net/http.ParseTime":                                       "1.1",
+	"net/http.Protocols":                                       "1.24",
+	"net/http.PushOptions":                                     "1.8",
+	"net/http.Pusher":                                          "1.8",
+	"net/http.ResponseController":                              "1.20",
+	"net/http.SameSite":                                        "1.11",
+	"net/http.SameSiteDefaultMode":                             "1.11",
+	"net/http.SameSiteLaxMode":                                 "1.11",
+	"net/http.SameSiteNoneMode":                                "1.13",
+	"net/http.SameSiteStrictMode":                              "1.11",
+	"net/http.ServeFileFS":                                     "1.22",
+	"net/http.ServeTLS":                                        "1.9",
+	"net/http.ServerContextKey":                                "1.7",
+	"net/http.StateActive":                                     "1.3",
+	"net/http.StateClosed":                                     "1.3",
+	"net/http.StateHijacked":                                   "1.3",
+	"net/http.StateIdle":                                       "1.3",
+	"net/http.StateNew":                                        "1.3",
+	"net/http.StatusAlreadyReported":                           "1.7",
+	"net/http.StatusEarlyHints":                                "1.13",
+	"net/http.StatusFailedDependency":                          "1.7",
+	"net/http.StatusIMUsed":                                    "1.7",
+	"net/http.StatusInsufficientStorage":                       "1.7",
+	"net/http.StatusLocked":                                    "1.7",
+	"net/http.StatusLoopDetected":                              "1.7",
+	"net/http.StatusMisdirectedRequest":                        "1.11",
+	"net/http.StatusMultiStatus":                               "1.7",
+	"net/http.StatusNetworkAuthenticationRequired":             "1.6",
+	"net/http.StatusNotExtended":                               "1.7",
+	"net/http.StatusPermanentRedirect":                         "1.7",
+	"net/http.StatusPreconditionRequired":                      "1.6",
+	"net/http.StatusProcessing":                                "1.7",
+	"net/http.StatusRequestHeaderFieldsTooLarge":               "1.6",
+	"net/http.StatusTooEarly":                                  "1.12",
+	"net/http.StatusTooManyRequests":                           "1.6",
+	"net/http.StatusUnavailableForLegalReasons":                "1.6",
+	"net/http.StatusUnprocessableEntity":                       "1.7",
+	"net/http.StatusUpgradeRequired":                           "1.7",
+	"net/http.StatusVariantAlsoNegotiates":                     "1.7",
+	"net/http.TrailerPrefix":                                   "1.8",
+	"net/http/fcgi.ErrConnClosed":                              "1.5",
+	"net/http/fcgi.ErrRequestAborted":                          "1.5",
+	"net/http/fcgi.ProcessEnv":                                 "1.9",
+	"net/http/httptest.NewRequest":                             "1.7",
+	"net/http/httptest.NewRequestWithContext":                  "1.23",
+	"net/http/httptest.NewTestServer":                          "1.27",
+	"net/http/httputil.BufferPool":                             "1.6",
+	"net/http/httputil.ProxyRequest":                           "1.20",
+	"net/http/pprof.Trace":                                     "1.5",
+	"net/mail.AddressParser":                                   "1.5",
+	"net/mail.ParseAddress":                                    "1.1",
+	"net/mail.ParseAddressList":                                "1.1",
+	"net/mail.ParseDate":                                       "1.8",
+	"net/netip.IPv6LinkLocalAllRouters":                        "1.20",
+	"net/netip.IPv6Loopback":                                   "1.20",
+	"net/textproto.TrimBytes":                                  "1.1",
+	"net/textproto.TrimString":                                 "1.1",
+	"net/url.InvalidHostError":                                 "1.6",
+	"net/url.JoinPath":                                         "1.19",
+	"net/url.PathEscape":                                       "1.8",
+	"net/url.PathUnescape":                                     "1.8",
+	"os.CopyFS":                                                "1.23",
+	"os.CreateTemp":                                            "1.16",
+	"os.DirEntry":                                              "1.16",
+	"os.DirFS":                                                 "1.16",
+	"os.ErrClosed":                                             "1.8",
+	"os.ErrDeadlineExceeded":                                   "1.15",
+	"os.ErrNoDeadline":                                         "1.10",
+	"os.ErrNoHandle":                                           "1.26",
+	"os.ErrProcessDone":                                        "1.16",
+	"os.Executable":                                            "1.8",
+	"os.IsTimeout":                                             "1.10",
+	"os.LookupEnv":                                             "1.5",
+	"os.MkdirTemp":                                             "1.16",
+	"os.ModeIrregular":                                         "1.11",
+	"os.OpenInRoot":                                            "1.24",
+	"os.OpenRoot":                                              "1.24",
+	"os.ReadDir":                                               "1.16",
+	"os.ReadFile":                                              "1.16",
+	"os.Root":                                                  "1.24",
+	"os.Unsetenv":                                              "1.4",
+	"os.UserCacheDir":                                          "1.11",
+	"os.UserConfigDir":                                         "1.13",
+	"os.UserHomeDir":                                           "1.12",
+	"os.WriteFile":                                             "1.16",
+	"os/exec.CommandContext":                                   "1.7",
+	"os/exec.ErrDot":                                           "1.19",
+	"os/exec.ErrWaitDelay":                                     "1.20",
+	"os/signal.Ignore":                                         "1.5",
+	"os/signal.Ignored":                                        "1.11",
+	"os/signal.NotifyContext":                                  "1.16",
+	"os/signal.Reset":                                          "1.5",
+	"os/signal.Stop":                                           "1.1",
+	"os/user.Group":                                            "1.7",
+	"os/user.LookupGroup":                                      "1.7",
+	"os/user.LookupGroupId":                                    "1.7",
+	"os/user.UnknownGroupError":                                "1.7",
+	"os/user.UnknownGroupIdError":                              "1.7",
+	"path/filepath.IsLocal":                                    "1.20",
+	"path/filepath.Localize":                                   "1.23",
+	"path/filepath.SkipAll":                                    "1.20",
+	"path/filepath.WalkDir":                                    "1.16",
+	"reflect.ArrayOf":                                          "1.5",
+	"reflect.ChanOf":                                           "1.1",
+	"reflect.FuncOf":                                           "1.5",
+	"reflect.MakeFunc":                                         "1.1",
+	"reflect.MakeMapWithSize":                                  "1.9",
+	"reflect.MapIter":                                          "1.12",
+	"reflect.MapOf":                                            "1.1",
+	"reflect.Pointer":                                          "1.18",
+	"reflect.PointerTo":                                        "1.18",
+	"reflect.Select":                                           "1.1",
+	"reflect.SelectCase":                                       "1.1",
+	"reflect.SelectDefault":                                    "1.1",
+	"reflect.SelectDir":                                        "1.1",
+	"reflect.SelectRecv":                                       "1.1",
+	"reflect.SelectSend":                                       "1.1",
+	"reflect.SliceAt":                                          "1.23",
+	"reflect.SliceOf":                                          "1.1",
+	"reflect.StructOf":                                         "1.7",
+	"reflect.Swapper":                                          "1.8",
+	"reflect.TypeAssert":                                       "1.25",
+	"reflect.TypeFor":                                          "1.22",
+	"reflect.VisibleFields":                                    "1.17",
+	"regexp/syntax.ErrLarge":                                   "1.20",
+	"regexp/syntax.ErrNestingDepth":                            "1.19",
+	"regexp/syntax.ErrUnexpectedParen":                         "1.1",
+	"runtime.AddCleanup":                                       "1.24",
+	"runtime.BlockProfile":                                     "1.1",
+	"runtime.BlockProfileRecord":                               "1.1",
+	"runtime.CallersFrames":                                    "1.7",
+	"runtime.Cleanup":                                          "1.24",
+	"runtime.Frame":                                            "1.7",
+	"runtime.Frames":                                           "1.7",
+	"runtime.KeepAlive":                                        "1.7",
+	"runtime.MutexProfile":                                     "1.8",
+	"runtime.PanicNilError":                                    "1.21",
+	"runtime.Pinner":                                           "1.21",
+	"runtime.ReadTrace":                                        "1.5",
+	"runtime.SetBlockProfileRate":                              "1.1",
+	"runtime.SetCgoTraceback":                                  "1.7",
+	"runtime.SetDefaultGOMAXPROCS":                             "1.25",
+	"runtime.SetMutexProfileFraction":                          "1.8",
+	"runtime.StartTrace":                                       "1.5",
+	"runtime.StopTrace":                                        "1.5",
+	"runtime/cgo.Incomplete":                                   "1.20",
+	"runtime/debug.BuildInfo":                                  "1.12",
+	"runtime/debug.BuildSetting":                               "1.18",
+	"runtime/debug.CrashOptions":                               "1.23",
+	"runtime/debug.FreeOSMemory":                               "1.1",
+	"runtime/debug.GCStats":                                    "1.1",
+	"runtime/debug.Module":                                     "1.12",
+	"runtime/debug.ParseBuildInfo":                             "1.18",
+	"runtime/debug.ReadBuildInfo":                              "1.12",
+	"runtime/debug.ReadGCStats":                                "1.1",
+	"runtime/debug.SetCrashOutput":                             "1.23",
+	"runtime/debug.SetGCPercent":                               "1.1",
+	"runtime/debug.SetMaxStack":                                "1.2",
+	"runtime/debug.SetMaxThreads":                              "1.2",
+	"runtime/debug.SetMemoryLimit":                             "1.19",
+	"runtime/debug.SetPanicOnFault":                            "1.3",
+	"runtime/debug.SetTraceback":                               "1.6",
+	"runtime/debug.WriteHeapDump":                              "1.3",
+	"runtime/pprof.Do":                                         "1.9",
+	"runtime/pprof.ForLabels":                                  "1.9",
+	"runtime/pprof.Label":                                      "1.9",
+	"runtime/pprof.LabelSet":                                   "1.9",
+	"runtime/pprof.Labels":                                     "1.9",
+	"runtime/pprof.SetGoroutineLabels":                         "1.9",
+	"runtime/pprof.WithLabels":                                 "1.9",
+	"runtime/trace.FlightRecorder":                             "1.25",
+	"runtime/trace.FlightRecorderConfig":                       "1.25",
+	"runtime/trace.IsEnabled":                                  "1.11",
+	"runtime/trace.Log":                                        "1.11",
+	"runtime/trace.Logf":                                       "1.11",
+	"runtime/trace.NewFlightRecorder":                          "1.25",
+	"runtime/trace.NewTask":                                    "1.11",
+	"runtime/trace.Region":                                     "1.11",
+	"runtime/trace.StartRegion":                                "1.11",
+	"runtime/trace.Task":                                       "1.11",
+	"runtime/trace.WithRegion":                                 "1.11",
+	"slices.All":                                               "1.23",
+	"slices.AppendSeq":                                         "1.23",
+	"slices.Backward":                                          "1.23",
+	"slices.Chunk":                                             "1.23",
+	"slices.Collect":                                           "1.23",
+	"slices.Concat":                                            "1.22",
+	"slices.Repeat":                                            "1.23",
+	"slices.Sorted":                                            "1.23",
+	"slices.SortedFunc":                                        "1.23",
+	"slices.SortedStableFunc":                                  "1.23",
+	"slices.Values":                                            "1.23",
+	"sort.Find":                                                "1.19",
+	"sort.Reverse":                                             "1.1",
+	"sort.Slice":                                               "1.8",
+	"sort.SliceIsSorted":                                       "1.8",
+	"sort.SliceStable":                                         "1.8",
+	"sort.Stable":                                              "1.2",
+	"strconv.AppendQuoteRuneToGraphic":                         "1.6",
+	"strconv.AppendQuoteToGraphic":                             "1.6",
+	"strconv.FormatComplex":                                    "1.15",
+	"strconv.IsGraphic":                                        "1.6",
+	"strconv.ParseComplex":                                     "1.15",
+	"strconv.QuoteRuneToGraphic":                               "1.6",
+	"strconv.QuoteToGraphic":                                   "1.6",
+	"strconv.QuotedPrefix":                                     "1.17",
+	"strings.Builder":                                          "1.10",
+	"strings.Clone":                                            "1.18",
+	"strings.Compare":                                          "1.5",
+	"strings.ContainsFunc":                                     "1.21",
+	"strings.Cut":                                              "1.18",
+	"strings.CutLast":                                          "1.27",
+	"strings.CutPrefix":                                        "1.20",
+	"strings.CutSuffix":                                        "1.20",
+	"strings.FieldsFuncSeq":                                    "1.24",
+	"strings.FieldsSeq":                                        "1.24",
+	"strings.IndexByte":                                        "1.2",
+	"strings.LastIndexByte":                                    "1.5",
+	"strings.Lines":                                            "1.24",
+	"strings.ReplaceAll":                                       "1.12",
+	"strings.SplitAfterSeq":                                    "1.24",
+	"strings.SplitSeq":                                         "1.24",
+	"strings.ToValidUTF8":                                      "1.13",
+	"strings.TrimPrefix":                                       "1.1",
+	"strings.TrimSuffix":                                       "1.1",
+	"sync.Map":                                                 "1.9",
+	"sync.OnceFunc":                                            "1.21",
+	"sync.OnceValue":                                           "1.21",
+	"sync.OnceValues":                                          "1.21",
+	"sync.Pool":                                                "1.3",
+	"sync/atomic.AndInt32":                                     "1.23",
+	"sync/atomic.AndInt64":                                     "1.23",
+	"sync/atomic.AndUint32":                                    "1.23",
+	"sync/atomic.AndUint64":                                    "1.23",
+	"sync/atomic.AndUintptr":                                   "1.23",
+	"sync/atomic.Bool":                                         "1.19",
+	"sync/atomic.Int32":                                        "1.19",
+	"sync/atomic.Int64":                                        "1.19",
+	"sync/atomic.OrInt32":                                      "1.23",
+	"sync/atomic.OrInt64":                                      "1.23",
+	"sync/atomic.OrUint32":                                     "1.23",
+	"sync/atomic.OrUint64":                                     "1.23",
+	"sync/atomic.OrUintptr":                                    "1.23",
+	"sync/atomic.Pointer":                                      "1.19",
+	"sync/atomic.SwapInt32":                                    "1.2",
+	"sync/atomic.SwapInt64":                                    "1.2",
+	"sync/atomic.SwapPointer":                                  "1.2",
+	"sync/atomic.SwapUint32":                                   "1.2",
+	"sync/atomic.SwapUint64":                                   "1.2",
+	"sync/atomic.SwapUintptr":                                  "1.2",
+	"sync/atomic.Uint32":                                       "1.19",
+	"sync/atomic.Uint64":                                       "1.19",
+	"sync/atomic.Uintptr":                                      "1.19",
+	"sync/atomic.Value":                                        "1.4",
+	"syscall.AF_ENCAP":                                         "1.1",
+	"syscall.AF_INET6_SDP":                                     "1.3",
+	"syscall.AF_INET_SDP":                                      "1.3",
+	"syscall.AF_MPLS":                                          "1.1",
+	"syscall.AF_OROUTE":                                        "1.1",
+	"syscall.AF_UTUN":                                          "1.16",
+	"syscall.AI_CANONNAME":                                     "1.1",
+	"syscall.AI_NUMERICHOST":                                   "1.1",
+	"syscall.AI_PASSIVE":                                       "1.1",
+	"syscall.ARPHRD_FRELAY":                                    "1.1",
+	"syscall.ARPHRD_STRIP":                                     "1.1",
+	"syscall.Accept4":                                          "1.1",
+	"syscall.AddrinfoW":                                        "1.1",
+	"syscall.AllThreadsSyscall":                                "1.16",
+	"syscall.AllThreadsSyscall6":                               "1.16",
+	"syscall.B14400":                                           "1.1",
+	"syscall.B28800":                                           "1.1",
+	"syscall.B7200":                                            "1.1",
+	"syscall.B76800":                                           "1.1",
+	"syscall.BASE_PROTOCOL":                                    "1.2",
+	"syscall.BIOCGDIRFILT":                                     "1.1",
+	"syscall.BIOCGFEEDBACK":                                    "1.1",
+	"syscall.BIOCGFILDROP":                                     "1.1",
+	"syscall.BIOCGSTATSOLD":                                    "1.1",
+	"syscall.BIOCGTSTAMP":                                      "1.1",
+	"syscall.BIOCSDIRFILT":                                     "1.1",
+	"syscall.BIOCSFEEDBACK":                                    "1.1",
+	"syscall.BIOCSFILDROP":                                     "1.1",
+	"syscall.BIOCSTCPF":                                        "1.1",
+	"syscall.BIOCSTSTAMP":                                      "1.1",
+	"syscall.BIOCSUDPF":                                        "1.1",
+	"syscall.BPF_ALIGNMENT32":                                  "1.1",
+	"syscall.BPF_DFLTBUFSIZE":                                  "1.1",
+	"syscall.BPF_DIRECTION_IN":                                 "1.1",
+	"syscall.BPF_DIRECTION_OUT":                                "1.1",
+	"syscall.BPF_T_BINTIME":                                    "1.1",
+	"syscall.BPF_T_BINTIME_FAST":                               "1.1",
+	"syscall.BPF_T_BINTIME_MONOTONIC":                          "1.1",
+	"syscall.BPF_T_BINTIME_MONOTONIC_FAST":                     "1.1",
+	"syscall.BPF_T_FAST":                                       "1.1",
+	"syscall.BPF_T_FLAG_MASK":                                  "1.1",
+	"syscall.BPF_T_FORMAT_MASK":                                "1.1",
+	"syscall.BPF_T_MICROTIME":                                  "1.1",
+	"syscall.BPF_T_MICROTIME_FAST":                             "1.1",
+	"syscall.BPF_T_MICROTIME_MONOTONIC":                        "1.1",
+	"syscall.BPF_T_MICROTIME_MONOTONIC_FAST":                   "1.1",
+	"syscall.BPF_T_MONOTONIC":                                  "1.1",
+	"syscall.BPF_T_MONOTONIC_FAST":                             "1.1",
+	"syscall.BPF_T_NANOTIME":                                   "1.1",
+	"syscall.BPF_T_NANOTIME_FAST":                              "1.1",
+	"syscall.BPF_T_NANOTIME_MONOTONIC":                         "1.1",
+	"syscall.BPF_T_NANOTIME_MONOTONIC_FAST":                    "1.1",
+	"syscall.BPF_T_NONE":                                       "1.1",
+	"syscall.BPF_T_NORMAL":                                     "1.1",
+	"syscall.BpfTimeval":                                       "1.2",
+	"syscall.BytePtrFromString":                                "1.1",
+	"syscall.ByteSliceFromString":                              "1.1",
+	"syscall.CCR0_FLUSH":                                       "1.1",
+	"syscall.CFLUSH":                                           "1.1",
+	"syscall.CLONE_CHILD_CLEARTID":                             "1.2",
+	"syscall.CLONE_CHILD_SETTID":                               "1.2",
+	"syscall.CLONE_CLEAR_SIGHAND":                              "1.20",
+	"syscall.CLONE_CSIGNAL":                                    "1.3",
+	"syscall.CLONE_DETACHED":                                   "1.2",
+	"syscall.CLONE_FILES":                                      "1.2",
+	"syscall.CLONE_FS":                                         "1.2",
+	"syscall.CLONE_INTO_CGROUP":                                "1.20",
+	"syscall.CLONE_IO":                                         "1.2",
+	"syscall.CLONE_NEWCGROUP":                                  "1.20",
+	"syscall.CLONE_NEWIPC":                                     "1.2",
+	"syscall.CLONE_NEWNET":                                     "1.2",
+	"syscall.CLONE_NEWNS":                                      "1.2",
+	"syscall.CLONE_NEWPID":                                     "1.2",
+	"syscall.CLONE_NEWTIME":                                    "1.20",
+	"syscall.CLONE_NEWUSER":                                    "1.2",
+	"syscall.CLONE_NEWUTS":                                     "1.2",
+	"syscall.CLONE_PARENT":                                     "1.2",
+	"syscall.CLONE_PARENT_SETTID":                              "1.2",
+	"syscall.CLONE_PID":                                        "1.3",
+	"syscall.CLONE_PIDFD":                                      "1.20",
+	"syscall.CLONE_PTRACE":                                     "1.2",
+	"syscall.CLONE_SETTLS":                                     "1.2",
+	"syscall.CLONE_SIGHAND":                                    "1.2",
+	"syscall.CLONE_SYSVSEM":                                    "1.2",
+	"syscall.CLONE_THREAD":                                     "1.2",
+	"syscall.CLONE_UNTRACED":                                   "1.2",
+	"syscall.CLONE_VFORK":                                      "1.2",
+	"syscall.CLONE_VM":                                         "1.2",
+	"syscall.CPUID_CFLUSH":                                     "1.1",
+	"syscall.CREATE_NEW_PROCESS_GROUP":                         "1.1",
+	"syscall.CSTART":                                           "1.1",
+	"syscall.CSTATUS":                                          "1.1",
+	"syscall.CSTOP":                                            "1.1",
+	"syscall.CSUSP":                                            "1.1",
+	"syscall.CTL_QUERY":                                        "1.1",
+	"syscall.CTRL_BREAK_EVENT":                                 "1.1",
+	"syscall.CTRL_CLOSE_EVENT":                                 "1.14",
+	"syscall.CTRL_C_EVENT":                                     "1.1",
+	"syscall.CTRL_LOGOFF_EVENT":                                "1.14",
+	"syscall.CTRL_SHUTDOWN_EVENT":                              "1.14",
+	"syscall.CancelIoEx":                                       "1.1",
+	"syscall.CertInfo":                                         "1.11",
+	"syscall.CertRevocationCrlInfo":                            "1.11",
+	"syscall.CertTrustListInfo":                                "1.11",
+	"syscall.Conn":                                             "1.9",
+	"syscall.ConnectEx":                                        "1.1",
+	"syscall.CreateHardLink":                                   "1.4",
+	"syscall.CreateProcessAsUser":                              "1.10",
+	"syscall.CreateSymbolicLink":                               "1.4",
+	"syscall.CreateToolhelp32Snapshot":                         "1.4",
+	"syscall.DIOCBSFLUSH":                                      "1.1",
+	"syscall.DIOCOSFPFLUSH":                                    "1.1",
+	"syscall.DLT_AOS":                                          "1.1",
+	"syscall.DLT_CAN_SOCKETCAN":                                "1.1",
+	"syscall.DLT_DBUS":                                         "1.1",
+	"syscall.DLT_DECT":                                         "1.1",
+	"syscall.DLT_DVB_CI":                                       "1.1",
+	"syscall.DLT_FC_2":                                         "1.1",
+	"syscall.DLT_FC_2_WITH_FRAME_DELIMS":                       "1.1",
+	"syscall.DLT_GSMTAP_ABIS":                                  "1.1",
+	"syscall.DLT_GSMTAP_UM":                                    "1.1",
+	"syscall.DLT_HDLC":                                         "1.1",
+	"syscall.DLT_HIPPI":                                        "1.1",
+	"syscall.DLT_IEEE802_15_4_NOFCS":                           "1.1",
+	"syscall.DLT_IPNET":                                        "1.1",
+	"syscall.DLT_IPOIB":                                        "1.1",
+	"syscall.DLT_IPV4":                                         "1.1",
+	"syscall.DLT_IPV6":                                         "1.1",
+	"syscall.DLT_JUNIPER_ATM_CEMIC":                            "1.1",
+	"syscall.DLT_JUNIPER_FIBRECHANNEL":                         "1.1",
+	"syscall.DLT_JUNIPER_SRX_E2E":                              "1.1",
+	"syscall.DLT_JUNIPER_VS":                                   "1.1",
+	"syscall.DLT_LINUX_EVDEV":                                  "1.1",
+	"syscall.DLT_MATCHING_MAX":                                 "1.1",
+	"syscall.DLT_MATCHING_MIN":                                 "1.1",
+	"syscall.DLT_MPEG_2_TS":                                    "1.1",
+	"syscall.DLT_MPLS":                                         "1.1",
+	"syscall.DLT_MUX27010":                                     "1.1",
+	"syscall.DLT_NETANALYZER":                                  "1.1",
+	"syscall.DLT_NETANALYZER_TRANSPARENT":                      "1.1",
+	"syscall.DLT_NFC_LLCP":                                     "1.1",
+	"syscall.DLT_NFLOG":                                        "1.1",
+	"syscall.DLT_NG40":                                         "1.1",
+	"syscall.DLT_RAWAF_MASK":                                   "1.1",
+	"syscall.DLT_STANAG_5066_D_PDU":                            "1.1",
+	"syscall.DLT_USB_LINUX_MMAPPED":                            "1.1",
+	"syscall.DLT_WIHART":                                       "1.1",
+	"syscall.DNS_INFO_NO_RECORDS":                              "1.4",
+	"syscall.DeviceIoControl":                                  "1.4",
+	"syscall.DnsNameCompare":                                   "1.4",
+	"syscall.DnsSectionAdditional":                             "1.4",
+	"syscall.DnsSectionAnswer":                                 "1.4",
+	"syscall.DnsSectionAuthority":                              "1.4",
+	"syscall.DnsSectionQuestion":                               "1.4",
+	"syscall.Dup3":                                             "1.2",
+	"syscall.ECAPMODE":                                         "1.1",
+	"syscall.EFER_LMA":                                         "1.1",
+	"syscall.EFER_LME":                                         "1.1",
+	"syscall.EFER_NXE":                                         "1.1",
+	"syscall.EFER_SCE":                                         "1.1",
+	"syscall.EIPSEC":                                           "1.1",
+	"syscall.EMT_TAGOVF":                                       "1.1",
+	"syscall.EMUL_ENABLED":                                     "1.1",
+	"syscall.EMUL_LINUX":                                       "1.1",
+	"syscall.EMUL_LINUX32":                                     "1.1",
+	"syscall.EMUL_MAXID":                                       "1.1",
+	"syscall.EMUL_NATIVE":                                      "1.1",
+	"syscall.ENDRUNDISC":                                       "1.1",
+	"syscall.EN_SW_CTL_INF":                                    "1.1",
+	"syscall.EN_SW_CTL_PREC":                                   "1.1",
+	"syscall.EN_SW_CTL_ROUND":                                  "1.1",
+	"syscall.EN_SW_DATACHAIN":                                  "1.1",
+	"syscall.EN_SW_DENORM":                                     "1.1",
+	"syscall.EN_SW_INVOP":                                      "1.1",
+	"syscall.EN_SW_OVERFLOW":                                   "1.1",
+	"syscall.EN_SW_PRECLOSS":                                   "1.1",
+	"syscall.EN_SW_UNDERFLOW":                                  "1.1",
+	"syscall.EN_SW_ZERODIV":                                    "1.1",
+	"syscall.EQFULL":                                           "1.16",
+	"syscall.ERROR_DIR_NOT_EMPTY":                              "1.8",
+	"syscall.ERROR_HANDLE_EOF":                                 "1.2",
+	"syscall.ERROR_MORE_DATA":                                  "1.3",
+	"syscall.ERROR_NETNAME_DELETED":                            "1.3",
+	"syscall.ERROR_NOT_FOUND":                                  "1.1",
+	"syscall.ERROR_PRIVILEGE_NOT_HELD":                         "1.4",
+	"syscall.ETHERCAP_JUMBO_MTU":                               "1.1",
+	"syscall.ETHERCAP_VLAN_HWTAGGING":                          "1.1",
+	"syscall.ETHERCAP_VLAN_MTU":                                "1.1",
+	"syscall.ETHERMIN":                                         "1.1",
+	"syscall.ETHERMTU":                                         "1.1",
+	"syscall.ETHERMTU_JUMBO":                                   "1.1",
+	"syscall.ETHERTYPE_8023":                                   "1.1",
+	"syscall.ETHERTYPE_AARP":                                   "1.1",
+	"syscall.ETHERTYPE_ACCTON":                                 "1.1",
+	"syscall.ETHERTYPE_AEONIC":                                 "1.1",
+	"syscall.ETHERTYPE_ALPHA":                                  "1.1",
+	"syscall.ETHERTYPE_AMBER":                                  "1.1",
+	"syscall.ETHERTYPE_AMOEBA":                                 "1.1",
+	"syscall.ETHERTYPE_AOE":                                    "1.1",
+	"syscall.ETHERTYPE_APOLLO":                                 "1.1",
+	"syscall.ETHERTYPE_APOLLODOMAIN":                           "1.1",
+	"syscall.ETHERTYPE_APPLETALK":                              "1.1",
+	"syscall.ETHERTYPE_APPLITEK":                               "1.1",
+	"syscall.ETHERTYPE_ARGONAUT":                               "1.1",
+	"syscall.ETHERTYPE_ARP":                                    "1.1",
+	"syscall.ETHERTYPE_AT":                                     "1.1",
+	"syscall.ETHERTYPE_ATALK":                                  "1.1",
+	"syscall.ETHERTYPE_ATOMIC":                                 "1.1",
+	"syscall.ETHERTYPE_ATT":                                    "1.1",
+	"syscall.ETHERTYPE_ATTSTANFORD":                            "1.1",
+	"syscall.ETHERTYPE_AUTOPHON":                               "1.1",
+	"syscall.ETHERTYPE_AXIS":                                   "1.1",
+	"syscall.ETHERTYPE_BCLOOP":                                 "1.1",
+	"syscall.ETHERTYPE_BOFL":                                   "1.1",
+	"syscall.ETHERTYPE_CABLETRON":                              "1.1",
+	"syscall.ETHERTYPE_CHAOS":                                  "1.1",
+	"syscall.ETHERTYPE_COMDESIGN":                              "1.1",
+	"syscall.ETHERTYPE_COMPUGRAPHIC":                           "1.1",
+	"syscall.ETHERTYPE_COUNTERPOINT":                           "1.1",
+	"syscall.ETHERTYPE_CRONUS":                                 "1.1",
+	"syscall.ETHERTYPE_CRONUSVLN":                              "1.1",
+	"syscall.ETHERTYPE_DCA":                                    "1.1",
+	"syscall.ETHERTYPE_DDE":                                    "1.1",
+	"syscall.ETHERTYPE_DEBNI":                                  "1.1",
+	"syscall.ETHERTYPE_DECAM":                                  "1.1",
+	"syscall.ETHERTYPE_DECCUST":                                "1.1",
+	"syscall.ETHERTYPE_DECDIAG":                                "1.1",
+	"syscall.ETHERTYPE_DECDNS":                                 "1.1",
+	"syscall.ETHERTYPE_DECDTS":                                 "1.1",
+	"syscall.ETHERTYPE_DECEXPER":                               "1.1",
+	"syscall.ETHERTYPE_DECLAST":                                "1.1",
+	"syscall.ETHERTYPE_DECLTM":                                 "1.1",
+	"syscall.ETHERTYPE_DECMUMPS":                               "1.1",
+	"syscall.ETHERTYPE_DECNETBIOS":                             "1.1",
+	"syscall.ETHERTYPE_DELTACON":                               "1.1",
+	"syscall.ETHERTYPE_DIDDLE":                                 "1.1",
+	"syscall.ETHERTYPE_DLOG1":                                  "1.1",
+	"syscall.ETHERTYPE_DLOG2":                                  "1.1",
+	"syscall.ETHERTYPE_DN":                                     "1.1",
+	"syscall.ETHERTYPE_DOGFIGHT":                               "1.1",
+	"syscall.ETHERTYPE_DSMD":                                   "1.1",
+	"syscall.ETHERTYPE_ECMA":                                   "1.1",
+	"syscall.ETHERTYPE_ENCRYPT":                                "1.1",
+	"syscall.ETHERTYPE_ES":                                     "1.1",
+	"syscall.ETHERTYPE_EXCELAN":                                "1.1",
+	"syscall.ETHERTYPE_EXPERDATA":                              "1.1",
+	"syscall.ETHERTYPE_FLIP":                                   "1.1",
+	"syscall.ETHERTYPE_FLOWCONTROL":                            "1.1",
+	"syscall.ETHERTYPE_FRARP":                                  "1.1",
+	"syscall.ETHERTYPE_GENDYN":                                 "1.1",
+	"syscall.ETHERTYPE_HAYES":                                  "1.1",
+	"syscall.ETHERTYPE_HIPPI_FP":                               "1.1",
+	"syscall.ETHERTYPE_HITACHI":                                "1.1",
+	"syscall.ETHERTYPE_HP":                                     "1.1",
+	"syscall.ETHERTYPE_IEEEPUP":                                "1.1",
+	"syscall.ETHERTYPE_IEEEPUPAT":                              "1.1",
+	"syscall.ETHERTYPE_IMLBL":                                  "1.1",
+	"syscall.ETHERTYPE_IMLBLDIAG":                              "1.1",
+	"syscall.ETHERTYPE_IP":                                     "1.1",
+	"syscall.ETHERTYPE_IPAS":                                   "1.1",
+	"syscall.ETHERTYPE_IPV6":                                   "1.1",
+	"syscall.ETHERTYPE_IPX":                                    "1.1",
+	"syscall.ETHERTYPE_IPXNEW":                                 "1.1",
+	"syscall.ETHERTYPE_KALPANA":                                "1.1",
+	"syscall.ETHERTYPE_LANBRIDGE":                              "1.1",
+	"syscall.ETHERTYPE_LANPROBE":                               "1.1",
+	"syscall.ETHERTYPE_LAT":                                    "1.1",
+	"syscall.ETHERTYPE_LBACK":                                  "1.1",
+	"syscall.ETHERTYPE_LITTLE":                                 "1.1",
+	"syscall.ETHERTYPE_LLDP":                                   "1.1",
+	"syscall.ETHERTYPE_LOGICRAFT":                              "1.1",
+	"syscall.ETHERTYPE_LOOPBACK":                               "1.1",
+	"syscall.ETHERTYPE_MATRA":                                  "1.1",
+	"syscall.ETHERTYPE_MAX":                                    "1.1",
+	"syscall.ETHERTYPE_MERIT":                                  "1.1",
+	"syscall.ETHERTYPE_MICP":                                   "1.1",
+	"syscall.ETHERTYPE_MOPDL":                                  "1.1",
+	"syscall.ETHERTYPE_MOPRC":                                  "1.1",
+	"syscall.ETHERTYPE_MOTOROLA":                               "1.1",
+	"syscall.ETHERTYPE_MPLS":                                   "1.1",
+	"syscall.ETHERTYPE_MPLS_MCAST":                             "1.1",
+	"syscall.ETHERTYPE_MUMPS":                                  "1.1",
+	"syscall.ETHERTYPE_NBPCC":                                  "1.1",
+	"syscall.ETHERTYPE_NBPCLAIM":                               "1.1",
+	"syscall.ETHERTYPE_NBPCLREQ":                               "1.1",
+	"syscall.ETHERTYPE_NBPCLRSP":                               "1.1",
+	"syscall.ETHERTYPE_NBPCREQ":                                "1.1",
+	"syscall.ETHERTYPE_NBPCRSP":                                "1.1",
+	"syscall.ETHERTYPE_NBPDG":                                  "1.1",
+	"syscall.ETHERTYPE_NBPDGB":                                 "1.1",
+	"syscall.ETHERTYPE_NBPDLTE":                                "1.1",
+	"syscall.ETHERTYPE_NBPRAR":                                 "1.1",
+	"syscall.ETHERTYPE_NBPRAS":                                 "1.1",
+	"syscall.ETHERTYPE_NBPRST":                                 "1.1",
+	"syscall.ETHERTYPE_NBPSCD":                                 "1.1",
+	"syscall.ETHERTYPE_NBPVCD":                                 "1.1",
+	"syscall.ETHERTYPE_NBS":                                    "1.1",
+	"syscall.ETHERTYPE_NCD":                                    "1.1",
+	"syscall.ETHERTYPE_NESTAR":                                 "1.1",
+	"syscall.ETHERTYPE_NETBEUI":                                "1.1",
+	"syscall.ETHERTYPE_NOVELL":                                 "1.1",
+	"syscall.ETHERTYPE_NS":                                     "1.1",
+	"syscall.ETHERTYPE_NSAT":                                   "1.1",
+	"syscall.ETHERTYPE_NSCOMPAT":                               "1.1",
+	"syscall.ETHERTYPE_NTRAILER":                               "1.1",
+	"syscall.ETHERTYPE_OS9":                                    "1.1",
+	"syscall.ETHERTYPE_OS9NET":                                 "1.1",
+	"syscall.ETHERTYPE_PACER":                                  "1.1",
+	"syscall.ETHERTYPE_PAE":                                    "1.1",
+	"syscall.ETHERTYPE_PCS":                                    "1.1",
+	"syscall.ETHERTYPE_PLANNING":                               "1.1",
+	"syscall.ETHERTYPE_PPP":                                    "1.1",
+	"syscall.ETHERTYPE_PPPOE":                                  "1.1",
+	"syscall.ETHERTYPE_PPPOEDISC":                              "1.1",
+	"syscall.ETHERTYPE_PRIMENTS":                               "1.1",
+	"syscall.ETHERTYPE_PUP":                                    "1.1",
+	"syscall.ETHERTYPE_PUPAT":                                  "1.1",
+	"syscall.ETHERTYPE_QINQ":                                   "1.1",
+	"syscall.ETHERTYPE_RACAL":                                  "1.1",
+	"syscall.ETHERTYPE_RATIONAL":                               "1.1",
+	"syscall.ETHERTYPE_RAWFR":                                  "1.1",
+	"syscall.ETHERTYPE_RCL":                                    "1.1",
+	"syscall.ETHERTYPE_RDP":                                    "1.1",
+	"syscall.ETHERTYPE_RETIX":                                  "1.1",
+	"syscall.ETHERTYPE_REVARP":                                 "1.1",
+	"syscall.ETHERTYPE_SCA":                                    "1.1",
+	"syscall.ETHERTYPE_SECTRA":                                 "1.1",
+	"syscall.ETHERTYPE_SECUREDATA":                             "1.1",
+	"syscall.ETHERTYPE_SGITW":                                  "1.1",
+	"syscall.ETHERTYPE_SG_BOUNCE":                              "1.1",
+	"syscall.ETHERTYPE_SG_DIAG":                                "1.1",
+	"syscall.ETHERTYPE_SG_NETGAMES":                            "1.1",
+	"syscall.ETHERTYPE_SG_RESV":                                "1.1",
+	"syscall.ETHERTYPE_SIMNET":                                 "1.1",
+	"syscall.ETHERTYPE_SLOW":                                   "1.1",
+	"syscall.ETHERTYPE_SLOWPROTOCOLS":                          "1.1",
+	"syscall.ETHERTYPE_SNA":                                    "1.1",
+	"syscall.ETHERTYPE_SNMP":                                   "1.1",
+	"syscall.ETHERTYPE_SONIX":                                  "1.1",
+	"syscall.ETHERTYPE_SPIDER":                                 "1.1",
+	"syscall.ETHERTYPE_SPRITE":                                 "1.1",
+	"syscall.ETHERTYPE_STP":                                    "1.1",
+	"syscall.ETHERTYPE_TALARIS":                                "1.1",
+	"syscall.ETHERTYPE_TALARISMC":                              "1.1",
+	"syscall.ETHERTYPE_TCPCOMP":                                "1.1",
+	"syscall.ETHERTYPE_TCPSM":                                  "1.1",
+	"syscall.ETHERTYPE_TEC":                                    "1.1",
+	"syscall.ETHERTYPE_TIGAN":                                  "1.1",
+	"syscall.ETHERTYPE_TRAIL":                                  "1.1",
+	"syscall.ETHERTYPE_TRANSETHER":                             "1.1",
+	"syscall.ETHERTYPE_TYMSHARE":                               "1.1",
+	"syscall.ETHERTYPE_UBBST":                                  "1.1",
+	"syscall.ETHERTYPE_UBDEBUG":                                "1.1",
+	"syscall.ETHERTYPE_UBDIAGLOOP":                             "1.1",
+	"syscall.ETHERTYPE_UBDL":                                   "1.1",
+	"syscall.ETHERTYPE_UBNIU":                                  "1.1",
+	"syscall.ETHERTYPE_UBNMC":                                  "1.1",
+	"syscall.ETHERTYPE_VALID":                                  "1.1",
+	"syscall.ETHERTYPE_VARIAN":                                 "1.1",
+	"syscall.ETHERTYPE_VAXELN":                                 "1.1",
+	"syscall.ETHERTYPE_VEECO":                                  "1.1",
+	"syscall.ETHERTYPE_VEXP":                                   "1.1",
+	"syscall.ETHERTYPE_VGLAB":                                  "1.1",
+	"syscall.ETHERTYPE_VINES":                                  "1.1",
+	"syscall.ETHERTYPE_VINESECHO":                              "1.1",
+	"syscall.ETHERTYPE_VINESLOOP":                              "1.1",
+	"syscall.ETHERTYPE_VITAL":                                  "1.1",
+	"syscall.ETHERTYPE_VLAN":                                   "1.1",
+	"syscall.ETHERTYPE_VLTLMAN":                                "1.1",
+	"syscall.ETHERTYPE_VPROD":                                  "1.1",
+	"syscall.ETHERTYPE_VURESERVED":                             "1.1",
+	"syscall.ETHERTYPE_WATERLOO":                               "1.1",
+	"syscall.ETHERTYPE_WELLFLEET":                              "1.1",
+	"syscall.ETHERTYPE_X25":                                    "1.1",
+	"syscall.ETHERTYPE_X75":                                    "1.1",
+	"syscall.ETHERTYPE_XNSSM":                                  "1.1",
+	"syscall.ETHERTYPE_XTP":                                    "1.1",
+	"syscall.ETHER_ADDR_LEN":                                   "1.1",
+	"syscall.ETHER_ALIGN":                                      "1.1",
+	"syscall.ETHER_CRC_LEN":                                    "1.1",
+	"syscall.ETHER_CRC_POLY_BE":                                "1.1",
+	"syscall.ETHER_CRC_POLY_LE":                                "1.1",
+	"syscall.ETHER_HDR_LEN":                                    "1.1",
+	"syscall.ETHER_MAX_DIX_LEN":                                "1.1",
+	"syscall.ETHER_MAX_LEN":                                    "1.1",
+	"syscall.ETHER_MAX_LEN_JUMBO":                              "1.1",
+	"syscall.ETHER_MIN_LEN":                                    "1.1",
+	"syscall.ETHER_PPPOE_ENCAP_LEN":                            "1.1",
+	"syscall.ETHER_TYPE_LEN":                                   "1.1",
+	"syscall.ETHER_VLAN_ENCAP_LEN":                             "1.1",
+	"syscall.EV_DROP":                                          "1.3",
+	"syscall.FILE_ATTRIBUTE_REPARSE_POINT":                     "1.4",
+	"syscall.FILE_FLAG_OPEN_REPARSE_POINT":                     "1.4",
+	"syscall.FILE_SKIP_COMPLETION_PORT_ON_SUCCESS":             "1.2",
+	"syscall.FILE_SKIP_SET_EVENT_ON_HANDLE":                    "1.2",
+	"syscall.FSCTL_GET_REPARSE_POINT":                          "1.4",
+	"syscall.F_CLOSEM":                                         "1.1",
+	"syscall.F_DUP2FD_CLOEXEC":                                 "1.1",
+	"syscall.F_FINDSIGS":                                       "1.16",
+	"syscall.F_FSCTL":                                          "1.1",
+	"syscall.F_FSDIRMASK":                                      "1.1",
+	"syscall.F_FSIN":                                           "1.1",
+	"syscall.F_FSINOUT":                                        "1.1",
+	"syscall.F_FSOUT":                                          "1.1",
+	"syscall.F_FSPRIV":                                         "1.1",
+	"syscall.F_FSVOID":                                         "1.1",
+	"syscall.F_GETCODEDIR":                                     "1.16",
+	"syscall.F_GETPROTECTIONLEVEL":                             "1.16",
+	"syscall.F_MAXFD":                                          "1.1",
+	"syscall.F_PARAM_MASK":                                     "1.1",
+	"syscall.F_PARAM_MAX":                                      "1.1",
+	"syscall.F_SETLKWTIMEOUT":                                  "1.16",
+	"syscall.F_SINGLE_WRITER":                                  "1.16",
+	"syscall.F_TRANSCODEKEY":                                   "1.16",
+	"syscall.FcntlFlock":                                       "1.3",
+	"syscall.FreeAddrInfoW":                                    "1.1",
+	"syscall.Fstatat":                                          "1.12",
+	"syscall.FullPath":                                         "1.4",
+	"syscall.GUID":                                             "1.1",
+	"syscall.GetAddrInfoW":                                     "1.1",
+	"syscall.GetConsoleMode":                                   "1.1",
+	"syscall.Getsockopt":                                       "1.1",
+	"syscall.GetsockoptICMPv6Filter":                           "1.2",
+	"syscall.GetsockoptIPv6MTUInfo":                            "1.2",
+	"syscall.GetsockoptUcred":                                  "1.1",
+	"syscall.Getxattr":                                         "1.1",
+	"syscall.ICMP6_FILTER":                                     "1.2",
+	"syscall.ICMPV6_FILTER":                                    "1.2",
+	"syscall.ICMPv6Filter":                                     "1.2",
+	"syscall.IFAN_ARRIVAL":                                     "1.1",
+	"syscall.IFAN_DEPARTURE":                                   "1.1",
+	"syscall.IFA_ROUTE":                                        "1.1",
+	"syscall.IFF_CANTCONFIG":                                   "1.1",
+	"syscall.IFT_BLUETOOTH":                                    "1.1",
+	"syscall.IFT_DOCSCABLEUPSTREAMCHANNEL":                     "1.1",
+	"syscall.IFT_DUMMY":                                        "1.1",
+	"syscall.IFT_ECONET":                                       "1.1",
+	"syscall.IFT_INFINIBAND":                                   "1.1",
+	"syscall.IFT_LINEGROUP":                                    "1.1",
+	"syscall.IFT_PFLOW":                                        "1.1",
+	"syscall.IFT_PON155":                                       "1.1",
+	"syscall.IFT_PON622":                                       "1.1",
+	"syscall.IFT_PROPATM":                                      "1.1",
+	"syscall.IFT_Q2931":                                        "1.1",
+	"syscall.IFT_SIPSIG":                                       "1.1",
+	"syscall.IFT_SIPTG":                                        "1.1",
+	"syscall.IFT_TELINK":                                       "1.1",
+	"syscall.IFT_VIRTUALTG":                                    "1.1",
+	"syscall.IFT_VOICEDID":                                     "1.1",
+	"syscall.IFT_VOICEEMFGD":                                   "1.1",
+	"syscall.IFT_VOICEFGDEANA":                                 "1.1",
+	"syscall.IFT_VOICEOVERCABLE":                               "1.1",
+	"syscall.IN_RFC3021_HOST":                                  "1.1",
+	"syscall.IN_RFC3021_MASK":                                  "1.1",
+	"syscall.IN_RFC3021_NET":                                   "1.1",
+	"syscall.IN_RFC3021_NSHIFT":                                "1.1",
+	"syscall.IOC_IN":                                           "1.1",
+	"syscall.IOC_INOUT":                                        "1.1",
+	"syscall.IOC_OUT":                                          "1.1",
+	"syscall.IOC_VENDOR":                                       "1.3",
+	"syscall.IOC_WS2":                                          "1.1",
+	"syscall.IO_REPARSE_TAG_SYMLINK":                           "1.4",
+	"syscall.IPPROTO_DIVERT_INIT":                              "1.3",
+	"syscall.IPPROTO_DIVERT_RESP":                              "1.3",
+	"syscall.IPPROTO_IPV6_ICMP":                                "1.1",
+	"syscall.IPPROTO_MH":                                       "1.1",
+	"syscall.IPPROTO_MPLS":                                     "1.1",
+	"syscall.IPPROTO_SEND":                                     "1.1",
+	"syscall.IPPROTO_VRRP":                                     "1.1",
+	"syscall.IPV6_AUTH_LEVEL":                                  "1.1",
+	"syscall.IPV6_ESP_NETWORK_LEVEL":                           "1.1",
+	"syscall.IPV6_ESP_TRANS_LEVEL":                             "1.1",
+	"syscall.IPV6_IPCOMP_LEVEL":                                "1.1",
+	"syscall.IPV6_OPTIONS":                                     "1.1",
+	"syscall.IPV6_PIPEX":                                       "1.1",
+	"syscall.IPV6_RECVDSTPORT":                                 "1.3",
+	"syscall.IPV6_RTABLE":                                      "1.1",
+	"syscall.IP_AUTH_LEVEL":                                    "1.1",
+	"syscall.IP_DIVERTFL":                                      "1.3",
+	"syscall.IP_EF":                                            "1.1",
+	"syscall.IP_ERRORMTU":                                      "1.1",
+	"syscall.IP_ESP_NETWORK_LEVEL":                             "1.1",
+	"syscall.IP_ESP_TRANS_LEVEL":                               "1.1",
+	"syscall.IP_IPCOMP_LEVEL":                                  "1.1",
+	"syscall.IP_IPSECFLOWINFO":                                 "1.1",
+	"syscall.IP_IPSEC_LOCAL_AUTH":                              "1.1",
+	"syscall.IP_IPSEC_LOCAL_CRED":                              "1.1",
+	"syscall.IP_IPSEC_LOCAL_ID":                                "1.1",
+	"syscall.IP_IPSEC_REMOTE_AUTH":                             "1.1",
+	"syscall.IP_IPSEC_REMOTE_CRED":                             "1.1",
+	"syscall.IP_IPSEC_REMOTE_ID":                               "1.1",
+	"syscall.IP_MINFRAGSIZE":                                   "1.1",
+	"syscall.IP_PIPEX":                                         "1.1",
+	"syscall.IP_RECVDSTPORT":                                   "1.1",
+	"syscall.IP_RECVRTABLE":                                    "1.1",
+	"syscall.IP_RTABLE":                                        "1.1",
+	"syscall.IPv6MTUInfo":                                      "1.2",
+	"syscall.IfAnnounceMsghdr":                                 "1.1",
+	"syscall.InterfaceAnnounceMessage":                         "1.1",
+	"syscall.LAYERED_PROTOCOL":                                 "1.2",
+	"syscall.LCNT_OVERLOAD_FLUSH":                              "1.1",
+	"syscall.Listxattr":                                        "1.1",
+	"syscall.LoadCancelIoEx":                                   "1.1",
+	"syscall.LoadConnectEx":                                    "1.1",
+	"syscall.LoadCreateSymbolicLink":                           "1.4",
+	"syscall.LoadGetAddrInfo":                                  "1.1",
+	"syscall.LoadSetFileCompletionNotificationModes":           "1.2",
+	"syscall.MADV_AUTOSYNC":                                    "1.1",
+	"syscall.MADV_CORE":                                        "1.1",
+	"syscall.MADV_NOCORE":                                      "1.1",
+	"syscall.MADV_NOSYNC":                                      "1.1",
+	"syscall.MADV_PROTECT":                                     "1.1",
+	"syscall.MADV_SPACEAVAIL":                                  "1.3",
+	"syscall.MAP_ALIGNED_SUPER":                                "1.3",
+	"syscall.MAP_ALIGNMENT_16MB":                               "1.3",
+	"syscall.MAP_ALIGNMENT_1TB":                                "1.3",
+	"syscall.MAP_ALIGNMENT_256TB":                              "1.3",
+	"syscall.MAP_ALIGNMENT_4GB":                                "1.3",
+	"syscall.MAP_ALIGNMENT_64KB":                               "1.3",
+	"syscall.MAP_ALIGNMENT_64PB":                               "1.3",
+	"syscall.MAP_ALIGNMENT_MASK":                               "1.3",
+	"syscall.MAP_ALIGNMENT_SHIFT":                              "1.3",
+	"syscall.MAP_FLAGMASK":                                     "1.3",
+	"syscall.MAP_INHERIT":                                      "1.3",
+	"syscall.MAP_INHERIT_COPY":                                 "1.3",
+	"syscall.MAP_INHERIT_DEFAULT":                              "1.3",
+	"syscall.MAP_INHERIT_DONATE_COPY":                          "1.3",
+	"syscall.MAP_INHERIT_NONE":                                 "1.3",
+	"syscall.MAP_INHERIT_SHARE":                                "1.3",
+	"syscall.MAP_NOCORE":                                       "1.1",
+	"syscall.MAP_NOSYNC":                                       "1.1",
+	"syscall.MAP_PREFAULT_READ":                                "1.1",
+	"syscall.MAP_RESERVED0100":                                 "1.1",
+	"syscall.MAP_TRYFIXED":                                     "1.3",
+	"syscall.MAP_WIRED":                                        "1.3",
+	"syscall.MAXIMUM_REPARSE_DATA_BUFFER_SIZE":                 "1.4",
+	"syscall.MAX_PROTOCOL_CHAIN":                               "1.2",
+	"syscall.MSG_BCAST":                                        "1.1",
+	"syscall.MSG_CONTROLMBUF":                                  "1.1",
+	"syscall.MSG_FASTOPEN":                                     "1.1",
+	"syscall.MSG_IOVUSRSPACE":                                  "1.1",
+	"syscall.MSG_LENUSRSPACE":                                  "1.1",
+	"syscall.MSG_MCAST":                                        "1.1",
+	"syscall.MSG_NAMEMBUF":                                     "1.1",
+	"syscall.MSG_USERFLAGS":                                    "1.1",
+	"syscall.Mclpool":                                          "1.2",
+	"syscall.NET_RT_IFLISTL":                                   "1.1",
+	"syscall.NET_RT_OIFLIST":                                   "1.1",
+	"syscall.NET_RT_OOIFLIST":                                  "1.1",
+	"syscall.NET_RT_STATS":                                     "1.1",
+	"syscall.NET_RT_TABLE":                                     "1.1",
+	"syscall.NOTE_BACKGROUND":                                  "1.16",
+	"syscall.NOTE_CRITICAL":                                    "1.16",
+	"syscall.NOTE_EOF":                                         "1.1",
+	"syscall.NOTE_EXIT_CSERROR":                                "1.16",
+	"syscall.NOTE_EXIT_DECRYPTFAIL":                            "1.16",
+	"syscall.NOTE_EXIT_DETAIL":                                 "1.16",
+	"syscall.NOTE_EXIT_DETAIL_MASK":                            "1.16",
+	"syscall.NOTE_EXIT_MEMORY":                                 "1.16",
+	"syscall.NOTE_EXIT_REPARENTED":                             "1.16",
+	"syscall.NOTE_LEEWAY":                                      "1.16",
+	"syscall.NOTE_TRUNCATE":                                    "1.1",
+	"syscall.NetGetJoinInformation":                            "1.2",
+	"syscall.NetSetupDomainName":                               "1.2",
+	"syscall.NetSetupUnjoined":                                 "1.2",
+	"syscall.NetSetupUnknownStatus":                            "1.2",
+	"syscall.NetSetupWorkgroupName":                            "1.2",
+	"syscall.NewCallbackCDecl":                                 "1.3",
+	"syscall.OFIOGETBMAP":                                      "1.1",
+	"syscall.ONOEOT":                                           "1.1",
+	"syscall.O_ALT_IO":                                         "1.1",
+	"syscall.O_DP_GETRAWENCRYPTED":                             "1.16",
+	"syscall.O_NOSIGPIPE":                                      "1.1",
+	"syscall.PFL_HIDDEN":                                       "1.2",
+	"syscall.PFL_MATCHES_PROTOCOL_ZERO":                        "1.2",
+	"syscall.PFL_MULTIPLE_PROTO_ENTRIES":                       "1.2",
+	"syscall.PFL_NETWORKDIRECT_PROVIDER":                       "1.2",
+	"syscall.PFL_RECOMMENDED_PROTO_ENTRY":                      "1.2",
+	"syscall.PF_FLUSH":                                         "1.1",
+	"syscall.PMC5_PIPELINE_FLUSH":                              "1.1",
+	"syscall.PRIO_PGRP":                                        "1.2",
+	"syscall.PRIO_PROCESS":                                     "1.2",
+	"syscall.PRIO_USER":                                        "1.2",
+	"syscall.PRI_IOFLUSH":                                      "1.1",
+	"syscall.PROCESS_TERMINATE":                                "1.2",
+	"syscall.PT_MASK":                                          "1.1",
+	"syscall.Pipe2":                                            "1.1",
+	"syscall.Pointer":                                          "1.11",
+	"syscall.Process32First":                                   "1.4",
+	"syscall.Process32Next":                                    "1.4",
+	"syscall.ProcessEntry32":                                   "1.4",
+	"syscall.PtraceSyscall":                                    "1.1",
+	"syscall.RLIMIT_CPU_USAGE_MONITOR":                         "1.16",
+	"syscall.RTAX_LABEL":                                       "1.1",
+	"syscall.RTAX_SRC":                                         "1.1",
+	"syscall.RTAX_SRCMASK":                                     "1.1",
+	"syscall.RTAX_TAG":                                         "1.1",
+	"syscall.RTA_LABEL":                                        "1.1",
+	"syscall.RTA_SRCMASK":                                      "1.1",
+	"syscall.RTA_TAG":                                          "1.1",
+	"syscall.RTF_ANNOUNCE":                                     "1.1",
+	"syscall.RTF_CLONED":                                       "1.1",
+	"syscall.RTF_GWFLAG_COMPAT":                                "1.3",
+	"syscall.RTF_MASK":                                         "1.1",
+	"syscall.RTF_MPATH":                                        "1.1",
+	"syscall.RTF_MPLS":                                         "1.1",
+	"syscall.RTF_PERMANENT_ARP":                                "1.1",
+	"syscall.RTF_PROXY":                                        "1.16",
+	"syscall.RTF_ROUTER":                                       "1.16",
+	"syscall.RTF_SOURCE":                                       "1.1",
+	"syscall.RTF_SRC":                                          "1.1",
+	"syscall.RTF_TUNNEL":                                       "1.1",
+	"syscall.RTF_USETRAILERS":                                  "1.1",
+	"syscall.RTM_CHGADDR":                                      "1.1",
+	"syscall.RTM_DESYNC":                                       "1.1",
+	"syscall.RTM_LLINFO_UPD":                                   "1.1",
+	"syscall.RTM_MAXSIZE":                                      "1.1",
+	"syscall.RTM_OIFINFO":                                      "1.1",
+	"syscall.RTM_OOIFINFO":                                     "1.1",
+	"syscall.RTM_SETGATE":                                      "1.1",
+	"syscall.RTNLGRP_IPV4_IFADDR":                              "1.1",
+	"syscall.RTNLGRP_IPV4_MROUTE":                              "1.1",
+	"syscall.RTNLGRP_IPV4_ROUTE":                               "1.1",
+	"syscall.RTNLGRP_IPV4_RULE":                                "1.1",
+	"syscall.RTNLGRP_IPV6_IFADDR":                              "1.1",
+	"syscall.RTNLGRP_IPV6_IFINFO":                              "1.1",
+	"syscall.RTNLGRP_IPV6_MROUTE":                              "1.1",
+	"syscall.RTNLGRP_IPV6_PREFIX":                              "1.1",
+	"syscall.RTNLGRP_IPV6_ROUTE":                               "1.1",
+	"syscall.RTNLGRP_IPV6_RULE":                                "1.1",
+	"syscall.RTNLGRP_LINK":                                     "1.1",
+	"syscall.RTNLGRP_ND_USEROPT":                               "1.1",
+	"syscall.RTNLGRP_NEIGH":                                    "1.1",
+	"syscall.RTNLGRP_NONE":                                     "1.1",
+	"syscall.RTNLGRP_NOTIFY":                                   "1.1",
+	"syscall.RTNLGRP_TC":                                       "1.1",
+	"syscall.RT_CACHING_CONTEXT":                               "1.1",
+	"syscall.RT_DEFAULT_FIB":                                   "1.1",
+	"syscall.RT_NORTREF":                                       "1.1",
+	"syscall.RT_TABLEID_MAX":                                   "1.1",
+	"syscall.RawConn":                                          "1.9",
+	"syscall.ReadConsole":                                      "1.1",
+	"syscall.Removexattr":                                      "1.1",
+	"syscall.SIGLIBRT":                                         "1.1",
+	"syscall.SIOCBRDGADD":                                      "1.1",
+	"syscall.SIOCBRDGADDS":                                     "1.1",
+	"syscall.SIOCBRDGARL":                                      "1.1",
+	"syscall.SIOCBRDGDADDR":                                    "1.1",
+	"syscall.SIOCBRDGDEL":                                      "1.1",
+	"syscall.SIOCBRDGDELS":                                     "1.1",
+	"syscall.SIOCBRDGFLUSH":                                    "1.1",
+	"syscall.SIOCBRDGFRL":                                      "1.1",
+	"syscall.SIOCBRDGGCACHE":                                   "1.1",
+	"syscall.SIOCBRDGGFD":                                      "1.1",
+	"syscall.SIOCBRDGGHT":                                      "1.1",
+	"syscall.SIOCBRDGGIFFLGS":                                  "1.1",
+	"syscall.SIOCBRDGGMA":                                      "1.1",
+	"syscall.SIOCBRDGGPARAM":                                   "1.1",
+	"syscall.SIOCBRDGGPRI":                                     "1.1",
+	"syscall.SIOCBRDGGRL":                                      "1.1",
+	"syscall.SIOCBRDGGSIFS":                                    "1.1",
+	"syscall.SIOCBRDGGTO":                                      "1.1",
+	"syscall.SIOCBRDGIFS":                                      "1.1",
+	"syscall.SIOCBRDGRTS":                                      "1.1",
+	"syscall.SIOCBRDGSADDR":                                    "1.1",
+	"syscall.SIOCBRDGSCACHE":                                   "1.1",
+	"syscall.SIOCBRDGSFD":                                      "1.1",
+	"syscall.SIOCBRDGSHT":                                      "1.1",
+	"syscall.SIOCBRDGSIFCOST":                                  "1.1",
+	"syscall.SIOCBRDGSIFFLGS":                                  "1.1",
+	"syscall.SIOCBRDGSIFPRIO":                                  "1.1",
+	"syscall.SIOCBRDGSMA":                                      "1.1",
+	"syscall.SIOCBRDGSPRI":                                     "1.1",
+	"syscall.SIOCBRDGSPROTO":                                   "1.1",
+	"syscall.SIOCBRDGSTO":                                      "1.1",
+	"syscall.SIOCBRDGSTXHC":                                    "1.1",
+	"syscall.SIOCGETKALIVE":                                    "1.1",
+	"syscall.SIOCGETLABEL":                                     "1.1",
+	"syscall.SIOCGETPFLOW":                                     "1.1",
+	"syscall.SIOCGETPFSYNC":                                    "1.1",
+	"syscall.SIOCGIFADDRPREF":                                  "1.1",
+	"syscall.SIOCGIFALIAS":                                     "1.1",
+	"syscall.SIOCGIFDATA":                                      "1.1",
+	"syscall.SIOCGIFDLT":                                       "1.1",
+	"syscall.SIOCGIFFIB":                                       "1.1",
+	"syscall.SIOCGIFGATTR":                                     "1.1",
+	"syscall.SIOCGIFHARDMTU":                                   "1.3",
+	"syscall.SIOCGIFPRIORITY":                                  "1.1",
+	"syscall.SIOCGIFRDOMAIN":                                   "1.1",
+	"syscall.SIOCGIFRTLABEL":                                   "1.1",
+	"syscall.SIOCGIFTIMESLOT":                                  "1.1",
+	"syscall.SIOCGIFXFLAGS":                                    "1.1",
+	"syscall.SIOCGLIFPHYRTABLE":                                "1.1",
+	"syscall.SIOCGLIFPHYTTL":                                   "1.3",
+	"syscall.SIOCGLINKSTR":                                     "1.1",
+	"syscall.SIOCGSPPPPARAMS":                                  "1.3",
+	"syscall.SIOCGVH":                                          "1.1",
+	"syscall.SIOCGVNETID":                                      "1.3",
+	"syscall.SIOCINITIFADDR":                                   "1.1",
+	"syscall.SIOCSETKALIVE":                                    "1.1",
+	"syscall.SIOCSETLABEL":                                     "1.1",
+	"syscall.SIOCSETPFLOW":                                     "1.1",
+	"syscall.SIOCSETPFSYNC":                                    "1.1",
+	"syscall.SIOCSIFADDRPREF":                                  "1.1",
+	"syscall.SIOCSIFFIB":                                       "1.1",
+	"syscall.SIOCSIFGATTR":                                     "1.1",
+	"syscall.SIOCSIFPRIORITY":                                  "1.1",
+	"syscall.SIOCSIFRDOMAIN":                                   "1.1",
+	"syscall.SIOCSIFRTLABEL":                                   "1.1",
+	"syscall.SIOCSIFTIMESLOT":                                  "1.1",
+	"syscall.SIOCSIFXFLAGS":                                    "1.1",
+	"syscall.SIOCSLIFPHYRTABLE":                                "1.1",
+	"syscall.SIOCSLIFPHYTTL":                                   "1.3",
+	"syscall.SIOCSLINKSTR":                                     "1.1",
+	"syscall.SIOCSSPPPPARAMS":                                  "1.3",
+	"syscall.SIOCSVH":                                          "1.1",
+	"syscall.SIOCSVNETID":                                      "1.3",
+	"syscall.SIOCZIFDATA":                                      "1.1",
+	"syscall.SIO_GET_EXTENSION_FUNCTION_POINTER":               "1.1",
+	"syscall.SIO_KEEPALIVE_VALS":                               "1.3",
+	"syscall.SIO_UDP_CONNRESET":                                "1.4",
+	"syscall.SOCK_FLAGS_MASK":                                  "1.1",
+	"syscall.SOCK_NOSIGPIPE":                                   "1.1",
+	"syscall.SO_BINDANY":                                       "1.1",
+	"syscall.SO_NETPROC":                                       "1.1",
+	"syscall.SO_NOHEADER":                                      "1.1",
+	"syscall.SO_NUMRCVPKT":                                     "1.16",
+	"syscall.SO_OVERFLOWED":                                    "1.1",
+	"syscall.SO_PROTOTYPE":                                     "1.1",
+	"syscall.SO_RTABLE":                                        "1.1",
+	"syscall.SO_SPLICE":                                        "1.1",
+	"syscall.SO_UPDATE_CONNECT_CONTEXT":                        "1.1",
+	"syscall.SO_USER_COOKIE":                                   "1.1",
+	"syscall.SO_VENDOR":                                        "1.3",
+	"syscall.SYMBOLIC_LINK_FLAG_DIRECTORY":                     "1.4",
+	"syscall.SYSCTL_VERSION":                                   "1.1",
+	"syscall.SYSCTL_VERS_0":                                    "1.1",
+	"syscall.SYSCTL_VERS_1":                                    "1.1",
+	"syscall.SYSCTL_VERS_MASK":                                 "1.1",
+	"syscall.SYS_ADJFREQ":                                      "1.1",
+	"syscall.SYS_AIO_MLOCK":                                    "1.14",
+	"syscall.SYS_AIO_WAITCOMPLETE":                             "1.14",
+	"syscall.SYS_BINDAT":                                       "1.3",
+	"syscall.SYS_CAP_FCNTLS_GET":                               "1.1",
+	"syscall.SYS_CAP_FCNTLS_LIMIT":                             "1.1",
+	"syscall.SYS_CAP_IOCTLS_GET":                               "1.1",
+	"syscall.SYS_CAP_IOCTLS_LIMIT":                             "1.1",
+	"syscall.SYS_CAP_RIGHTS_GET":                               "1.1",
+	"syscall.SYS_CAP_RIGHTS_LIMIT":                             "1.1",
+	"syscall.SYS_CHFLAGSAT":                                    "1.3",
+	"syscall.SYS_CLOCK_GETCPUCLOCKID2":                         "1.1",
+	"syscall.SYS_CONNECTAT":                                    "1.3",
+	"syscall.SYS_CSOPS_AUDITTOKEN":                             "1.16",
+	"syscall.SYS_FCHROOT":                                      "1.1",
+	"syscall.SYS_FKTRACE":                                      "1.1",
+	"syscall.SYS_FSTATVFS1":                                    "1.1",
+	"syscall.SYS_FSYNC_RANGE":                                  "1.1",
+	"syscall.SYS_FUTIMENS":                                     "1.1",
+	"syscall.SYS_GETDTABLECOUNT":                               "1.1",
+	"syscall.SYS_GETRTABLE":                                    "1.1",
+	"syscall.SYS_GETTHRID":                                     "1.1",
+	"syscall.SYS_GETVFSSTAT":                                   "1.1",
+	"syscall.SYS_GSSD_SYSCALL":                                 "1.14",
+	"syscall.SYS_KAS_INFO":                                     "1.16",
+	"syscall.SYS_KMQ_NOTIFY":                                   "1.14",
+	"syscall.SYS_KMQ_OPEN":                                     "1.14",
+	"syscall.SYS_KMQ_SETATTR":                                  "1.14",
+	"syscall.SYS_KMQ_TIMEDRECEIVE":                             "1.14",
+	"syscall.SYS_KMQ_TIMEDSEND":                                "1.14",
+	"syscall.SYS_KMQ_UNLINK":                                   "1.14",
+	"syscall.SYS_KQUEUE1":                                      "1.1",
+	"syscall.SYS_KSEM_CLOSE":                                   "1.14",
+	"syscall.SYS_KSEM_DESTROY":                                 "1.14",
+	"syscall.SYS_KSEM_GETVALUE":                                "1.14",
+	"syscall.SYS_KSEM_INIT":                                    "1.14",
+	"syscall.SYS_KSEM_OPEN":                                    "1.14",
+	"syscall.SYS_KSEM_POST":                                    "1.14",
+	"syscall.SYS_KSEM_TIMEDWAIT":                               "1.14",
+	"syscall.SYS_KSEM_TRYWAIT":                                 "1.14",
+	"syscall.SYS_KSEM_UNLINK":                                  "1.14",
+	"syscall.SYS_KSEM_WAIT":                                    "1.14",
+	"syscall.SYS_LEDGER":                                       "1.16",
+	"syscall.SYS_MODCTL":                                       "1.1",
+	"syscall.SYS_MQUERY":                                       "1.1",
+	"syscall.SYS_NLM_SYSCALL":                                  "1.14",
+	"syscall.SYS_NUMA_GETAFFINITY":                             "1.14",
+	"syscall.SYS_NUMA_SETAFFINITY":                             "1.14",
+	"syscall.SYS_OPEN_DPROTECTED_NP":                           "1.16",
+	"syscall.SYS_PACCEPT":                                      "1.1",
+	"syscall.SYS_PMC_CONTROL":                                  "1.1",
+	"syscall.SYS_PMC_GET_INFO":                                 "1.1",
+	"syscall.SYS_POLLTS":                                       "1.1",
+	"syscall.SYS_PROCCTL":                                      "1.3",
+	"syscall.SYS_PSET_ASSIGN":                                  "1.1",
+	"syscall.SYS_PSET_CREATE":                                  "1.1",
+	"syscall.SYS_PSET_DESTROY":                                 "1.1",
+	"syscall.SYS_RASCTL":                                       "1.1",
+	"syscall.SYS_SEMCONFIG":                                    "1.1",
+	"syscall.SYS_SETRTABLE":                                    "1.1",
+	"syscall.SYS_SIGQUEUEINFO":                                 "1.1",
+	"syscall.SYS_STATVFS1":                                     "1.1",
+	"syscall.SYS_SWAPCTL":                                      "1.1",
+	"syscall.SYS_VADVISE":                                      "1.1",
+	"syscall.SYS_WAIT6":                                        "1.1",
+	"syscall.SYS__LWP_CONTINUE":                                "1.1",
+	"syscall.SYS__LWP_CREATE":                                  "1.1",
+	"syscall.SYS__LWP_CTL":                                     "1.1",
+	"syscall.SYS__LWP_DETACH":                                  "1.1",
+	"syscall.SYS__LWP_EXIT":                                    "1.1",
+	"syscall.SYS__LWP_GETNAME":                                 "1.1",
+	"syscall.SYS__LWP_GETPRIVATE":                              "1.1",
+	"syscall.SYS__LWP_KILL":                                    "1.1",
+	"syscall.SYS__LWP_PARK":                                    "1.1",
+	"syscall.SYS__LWP_SELF":                                    "1.1",
+	"syscall.SYS__LWP_SETNAME":                                 "1.1",
+	"syscall.SYS__LWP_SETPRIVATE":                              "1.1",
+	"syscall.SYS__LWP_SUSPEND":                                 "1.1",
+	"syscall.SYS__LWP_UNPARK":                                  "1.1",
+	"syscall.SYS__LWP_UNPARK_ALL":                              "1.1",
+	"syscall.SYS__LWP_WAIT":                                    "1.1",
+	"syscall.SYS__LWP_WAKEUP":                                  "1.1",
+	"syscall.SYS__PSET_BIND":                                   "1.1",
+	"syscall.SYS__SCHED_GETAFFINITY":                           "1.1",
+	"syscall.SYS__SCHED_GETPARAM":                              "1.1",
+	"syscall.SYS__SCHED_SETAFFINITY":                           "1.1",
+	"syscall.SYS__SCHED_SETPARAM":                              "1.1",
+	"syscall.SYS___CAP_RIGHTS_GET":                             "1.14",
+	"syscall.SYS___CLONE":                                      "1.1",
+	"syscall.SYS___GETLOGIN":                                   "1.1",
+	"syscall.SYS___GET_TCB":                                    "1.1",
+	"syscall.SYS___POSIX_CHOWN":                                "1.1",
+	"syscall.SYS___POSIX_FCHOWN":                               "1.1",
+	"syscall.SYS___POSIX_LCHOWN":                               "1.1",
+	"syscall.SYS___POSIX_RENAME":                               "1.1",
+	"syscall.SYS___QUOTACTL":                                   "1.1",
+	"syscall.SYS___SEMCTL":                                     "1.1",
+	"syscall.SYS___SETLOGIN":                                   "1.1",
+	"syscall.SYS___SET_TCB":                                    "1.1",
+	"syscall.SYS___SIGACTION_SIGTRAMP":                         "1.1",
+	"syscall.SYS___SIGTIMEDWAIT":                               "1.1",
+	"syscall.SYS___TFORK":                                      "1.1",
+	"syscall.SYS___THREXIT":                                    "1.1",
+	"syscall.SYS___THRSIGDIVERT":                               "1.1",
+	"syscall.SYS___THRSLEEP":                                   "1.1",
+	"syscall.SYS___THRWAKEUP":                                  "1.1",
+	"syscall.S_ARCH1":                                          "1.1",
+	"syscall.S_ARCH2":                                          "1.1",
+	"syscall.S_LOGIN_SET":                                      "1.1",
+	"syscall.SendmsgN":                                         "1.3",
+	"syscall.SetFileCompletionNotificationModes":               "1.2",
+	"syscall.SetsockoptICMPv6Filter":                           "1.2",
+	"syscall.Setxattr":                                         "1.1",
+	"syscall.SizeofICMPv6Filter":                               "1.2",
+	"syscall.SizeofIPv6MTUInfo":                                "1.2",
+	"syscall.SizeofIfAnnounceMsghdr":                           "1.1",
+	"syscall.SizeofTCPInfo":                                    "1.1",
+	"syscall.SlicePtrFromStrings":                              "1.1",
+	"syscall.SysProcIDMap":                                     "1.4",
+	"syscall.Syscall18":                                        "1.12",
+	"syscall.SyscallN":                                         "1.18",
+	"syscall.Sysctlnode":                                       "1.2",
+	"syscall.TCIFLUSH":                                         "1.1",
+	"syscall.TCIOFLUSH":                                        "1.1",
+	"syscall.TCOFLUSH":                                         "1.1",
+	"syscall.TCPInfo":                                          "1.1",
+	"syscall.TCPKeepalive":                                     "1.3",
+	"syscall.TCP_CONGCTL":                                      "1.1",
+	"syscall.TCP_ENABLE_ECN":                                   "1.16",
+	"syscall.TCP_KEEPINIT":                                     "1.1",
+	"syscall.TCP_NOTSENT_LOWAT":                                "1.16",
+	"syscall.TCP_NSTATES":                                      "1.1",
+	"syscall.TCP_SACK_ENABLE":                                  "1.1",
+	"syscall.TCP_SENDMOREACKS":                                 "1.16",
+	"syscall.TCP_VENDOR":                                       "1.3",
+	"syscall.TCSAFLUSH":                                        "1.1",
+	"syscall.TH32CS_INHERIT":                                   "1.4",
+	"syscall.TH32CS_SNAPALL":                                   "1.4",
+	"syscall.TH32CS_SNAPHEAPLIST":                              "1.4",
+	"syscall.TH32CS_SNAPMODULE":                                "1.4",
+	"syscall.TH32CS_SNAPMODULE32":                              "1.4",
+	"syscall.TH32CS_SNAPPROCESS":                               "1.4",
+	"syscall.TH32CS_SNAPTHREAD":                                "1.4",
+	"syscall.TIOCFLAG_CDTRCTS":                                 "1.1",
+	"syscall.TIOCFLAG_CLOCAL":                                  "1.1",
+	"syscall.TIOCFLAG_CRTSCTS":                                 "1.1",
+	"syscall.TIOCFLAG_MDMBUF":                                  "1.1",
+	"syscall.TIOCFLAG_PPS":                                     "1.1",
+	"syscall.TIOCFLAG_SOFTCAR":                                 "1.1",
+	"syscall.TIOCGFLAGS":                                       "1.1",
+	"syscall.TIOCGLINED":                                       "1.1",
+	"syscall.TIOCGQSIZE":                                       "1.1",
+	"syscall.TIOCGRANTPT":                                      "1.1",
+	"syscall.TIOCGSIZE":                                        "1.1",
+	"syscall.TIOCGTSTAMP":                                      "1.1",
+	"syscall.TIOCPTMGET":                                       "1.1",
+	"syscall.TIOCPTSNAME":                                      "1.1",
+	"syscall.TIOCRCVFRAME":                                     "1.1",
+	"syscall.TIOCSFLAGS":                                       "1.1",
+	"syscall.TIOCSLINED":                                       "1.1",
+	"syscall.TIOCSQSIZE":                                       "1.1",
+	"syscall.TIOCSSIZE":                                        "1.1",
+	"syscall.TIOCSTSTAMP":                                      "1.1",
+	"syscall.TIOCXMTFRAME":                                     "1.1",
+	"syscall.TOKEN_ADJUST_SESSIONID":                           "1.11",
+	"syscall.UNIX_PATH_MAX":                                    "1.12",
+	"syscall.UTF16FromString":                                  "1.1",
+	"syscall.UTF16PtrFromString":                               "1.1",
+	"syscall.Unsetenv":                                         "1.4",
+	"syscall.UtimesNano":                                       "1.1",
+	"syscall.VDSUSP":                                           "1.1",
+	"syscall.VERASE2":                                          "1.1",
+	"syscall.VSTATUS":                                          "1.1",
+	"syscall.VT0":                                              "1.1",
+	"syscall.VT1":                                              "1.1",
+	"syscall.VTDLY":                                            "1.1",
+	"syscall.WALLSIG":                                          "1.1",
+	"syscall.WALTSIG":                                          "1.1",
+	"syscall.WNOZOMBIE":                                        "1.1",
+	"syscall.WOPTSCHECKED":                                     "1.1",
+	"syscall.WSAEACCES":                                        "1.2",
+	"syscall.WSAECONNABORTED":                                  "1.9",
+	"syscall.WSAECONNRESET":                                    "1.3",
+	"syscall.WSAENOPROTOOPT":                                   "1.23",
+	"syscall.WSAEnumProtocols":                                 "1.2",
+	"syscall.WSAID_CONNECTEX":                                  "1.1",
+	"syscall.WSAPROTOCOL_LEN":                                  "1.2",
+	"syscall.WSAProtocolChain":                                 "1.2",
+	"syscall.WSAProtocolInfo":                                  "1.2",
+	"syscall.WTRAPPED":                                         "1.1",
+	"syscall.WriteConsole":                                     "1.1",
+	"syscall.XP1_CONNECTIONLESS":                               "1.2",
+	"syscall.XP1_CONNECT_DATA":                                 "1.2",
+	"syscall.XP1_DISCONNECT_DATA":                              "1.2",
+	"syscall.XP1_EXPEDITED_DATA":                               "1.2",
+	"syscall.XP1_GRACEFUL_CLOSE":                               "1.2",
+	"syscall.XP1_GUARANTEED_DELIVERY":                          "1.2",
+	"syscall.XP1_GUARANTEED_ORDER":                             "1.2",
+	"syscall.XP1_IFS_HANDLES":                                  "1.2",
+	"syscall.XP1_MESSAGE_ORIENTED":                             "1.2",
+	"syscall.XP1_MULTIPOINT_CONTROL_PLANE":                     "1.2",
+	"syscall.XP1_MULTIPOINT_DATA_PLANE":                        "1.2",
+	"syscall.XP1_PARTIAL_MESSAGE":                              "1.2",
+	"syscall.XP1_PSEUDO_STREAM":                                "1.2",
+	"syscall.XP1_QOS_SUPPORTED":                                "1.2",
+	"syscall.XP1_SAN_SUPPORT_SDP":                              "1.2",
+	"syscall.XP1_SUPPORT_BROADCAST":                            "1.2",
+	"syscall.XP1_SUPPORT_MULTIPOINT":                           "1.2",
+	"syscall.XP1_UNI_RECV":                                     "1.2",
+	"syscall.XP1_UNI_SEND":                                     "1.2",
+	"testing.AllocsPerRun":                                     "1.1",
+	"testing.Cover":                                            "1.2",
+	"testing.CoverBlock":                                       "1.2",
+	"testing.CoverMode":                                        "1.8",
+	"testing.Coverage":                                         "1.4",
+	"testing.F":                                                "1.18",
+	"testing.Init":                                             "1.13",
+	"testing.InternalFuzzTarget":                               "1.18",
+	"testing.M":                                                "1.4",
+	"testing.MainStart":                                        "1.4",
+	"testing.PB":                                               "1.3",
+	"testing.RegisterCover":                                    "1.2",
+	"testing.TB":                                               "1.2",
+	"testing.Testing":                                          "1.21",
+	"testing.Verbose":                                          "1.1",
+	"testing/iotest.ErrReader":                                 "1.16",
+	"testing/iotest.TestReader":                                "1.16",
+	"testing/slogtest.Run":                                     "1.22",
+	"testing/synctest.Sleep":                                   "1.27",
+	"text/template.ExecError":                                  "1.6",
+	"text/template.IsTrue":                                     "1.6",
+	"text/template.ParseFS":                                    "1.16",
+	"text/template/parse.BreakNode":                            "1.18",
+	"text/template/parse.ChainNode":                            "1.1",
+	"text/template/parse.CommentNode":                          "1.16",
+	"text/template/parse.ContinueNode":                         "1.18",
+	"text/template/parse.Mode":                                 "1.16",
+	"text/template/parse.NilNode":                              "1.1",
+	"text/template/parse.NodeBreak":                            "1.18",
+	"text/template/parse.NodeChain":                            "1.1",
+	"text/template/parse.NodeComment":                          "1.16",
+	"text/template/parse.NodeContinue":                         "1.18",
+	"text/template/parse.NodeNil":                              "1.1",
+	"text/template/parse.ParseComments":                        "1.16",
+	"text/template/parse.Pos":                                  "1.1",
+	"text/template/parse.SkipFuncCheck":                        "1.17",
+	"time.DateOnly":                                            "1.20",
+	"time.DateTime":                                            "1.20",
+	"time.Layout":                                              "1.17",
+	"time.LoadLocationFromTZData":                              "1.10",
+	"time.ParseInLocation":                                     "1.1",
+	"time.TimeOnly":                                            "1.20",
+	"time.UnixMicro":                                           "1.17",
+	"time.UnixMilli":                                           "1.17",
+	"time.Until":                                               "1.8",
+	"unicode.Adlam":                                            "1.7",
+	"unicode.Ahom":                                             "1.5",
+	"unicode.Anatolian_Hieroglyphs":                            "1.5",
+	"unicode.Bassa_Vah":                                        "1.4",
+	"unicode.Beria_Erfe":                                       "1.27",
+	"unicode.Bhaiksuki":                                        "1.7",
+	"unicode.CategoryAliases":                                  "1.25",
+	"unicode.Caucasian_Albanian":                               "1.4",
+	"unicode.Chakma":                                           "1.1",
+	"unicode.Chorasmian":                                       "1.16",
+	"unicode.Cn":                                               "1.25",
+	"unicode.Cypro_Minoan":                                     "1.21",
+	"unicode.Dives_Akuru":                                      "1.16",
+	"unicode.Dogra":                                            "1.13",
+	"unicode.Duployan":                                         "1.4",
+	"unicode.Elbasan":                                          "1.4",
+	"unicode.Elymaic":                                          "1.14",
+	"unicode.Garay":                                            "1.27",
+	"unicode.Grantha":                                          "1.4",
+	"unicode.Gunjala_Gondi":                                    "1.13",
+	"unicode.Gurung_Khema":                                     "1.27",
+	"unicode.Hanifi_Rohingya":                                  "1.13",
+	"unicode.Hatran":                                           "1.5",
+	"unicode.IDS_Unary_Operator":                               "1.27",
+	"unicode.ID_Compat_Math_Continue":                          "1.27",
+	"unicode.ID_Compat_Math_Start":                             "1.27",
+	"unicode.In":                                               "1.2",
+	"unicode.Kawi":                                             "1.21",
+	"unicode.Khitan_Small_Script":                              "1.16",
+	"unicode.Khojki":                                           "1.4",
+	"unicode.Khudawadi":                                        "1.4",
+	"unicode.Kirat_Rai":                                        "1.27",
+	"unicode.LC":                                               "1.25",
+	"unicode.Linear_A":                                         "1.4",
+	"unicode.Mahajani":                                         "1.4",
+	"unicode.Makasar":                                          "1.13",
+	"unicode.Manichaean":                                       "1.4",
+	"unicode.Marchen":                                          "1.7",
+	"unicode.Masaram_Gondi":                                    "1.10",
+	"unicode.Medefaidrin":                                      "1.13",
+	"unicode.Mende_Kikakui":                                    "1.4",
+	"unicode.Meroitic_Cursive":                                 "1.1",
+	"unicode.Meroitic_Hieroglyphs":                             "1.1",
+	"unicode.Miao":                                             "1.1",
+	"unicode.Modi":                                             "1.4",
+	"unicode.Modifier_Combining_Mark":                          "1.27",
+	"unicode.Mro":                                              "1.4",
+	"unicode.Multani":                                          "1.5",
+	"unicode.Nabataean":                                        "1.4",
+	"unicode.Nag_Mundari":                                      "1.21",
+	"unicode.Nandinagari":                                      "1.14",
+	"unicode.Newa":                                             "1.7",
+	"unicode.Nushu":                                            "1.10",
+	"unicode.Nyiakeng_Puachue_Hmong":                           "1.14",
+	"unicode.Ol_Onal":                                          "1.27",
+	"unicode.Old_Hungarian":                                    "1.5",
+	"unicode.Old_North_Arabian":                                "1.4",
+	"unicode.Old_Permic":                                       "1.4",
+	"unicode.Old_Sogdian":                                      "1.13",
+	"unicode.Old_Uyghur":                                       "1.21",
+	"unicode.Osage":                                            "1.7",
+	"unicode.Pahawh_Hmong":                                     "1.4",
+	"unicode.Palmyrene":                                        "1.4",
+	"unicode.Pau_Cin_Hau":                                      "1.4",
+	"unicode.Prepended_Concatenation_Mark":                     "1.7",
+	"unicode.Psalter_Pahlavi":                                  "1.4",
+	"unicode.Regional_Indicator":                               "1.10",
+	"unicode.Sentence_Terminal":                                "1.7",
+	"unicode.Sharada":                                          "1.1",
+	"unicode.Siddham":                                          "1.4",
+	"unicode.Sidetic":                                          "1.27",
+	"unicode.SignWriting":                                      "1.5",
+	"unicode.Sogdian":                                          "1.13",
+	"unicode.Sora_Sompeng":                                     "1.1",
+	"unicode.Soyombo":                                          "1.10",
+	"unicode.Sunuwar":                                          "1.27",
+	"unicode.Tai_Yo":                                           "1.27",
+	"unicode.Takri":                                            "1.1",
+	"unicode.Tangsa":                                           "1.21",
+	"unicode.Tangut":                                           "1.7",
+	"unicode.Tirhuta":                                          "1.4",
+	"unicode.Todhri":                                           "1.27",
+	"unicode.Tolong_Siki":                                      "1.27",
+	"unicode.Toto":                                             "1.21",
+	"unicode.Tulu_Tigalari":                                    "1.27",
+	"unicode.Vithkuqi":                                         "1.21",
+	"unicode.Wancho":                                           "1.14",
+	"unicode.Warang_Citi":                                      "1.4",
+	"unicode.Yezidi":                                           "1.16",
+	"unicode.Zanabazar_Square":                                 "1.10",
+	"unicode/utf16.AppendRune":                                 "1.20",
+	"unicode/utf16.RuneLen":                                    "1.23",
+	"unicode/utf8.AppendRune":                                  "1.18",
+	"unicode/utf8.ValidRune":                                   "1.1",
+}
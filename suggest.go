@@ -0,0 +1,254 @@
+package main
+
+import (
+	"go/build"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// maxSuggestions is the number of import paths suggested for an unresolvable import.
+const maxSuggestions = 3
+
+var (
+	candidatesMu    sync.Mutex
+	candidatesCache = map[string][]string{} // by module directory ("" outside modules) and Go version
+)
+
+// isResolvable reports whether the package of the import path p is found for a file in dir:
+// in the vendor directories of dir and its parents, in the module of dir,
+// in the build context or in the module cache.
+func isResolvable(p, dir string) bool {
+	if p == "C" {
+		return true
+	}
+
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return false
+	}
+
+	m := findGoMod(dir)
+	for d := dir; ; d = filepath.Dir(d) {
+		if isPackageDir(filepath.Join(d, "vendor", filepath.FromSlash(p))) {
+			return true
+		}
+		if (m != nil && d == m.dir) || filepath.Dir(d) == d {
+			break
+		}
+	}
+
+	if m.containsPath(p) {
+		rel := strings.TrimPrefix(strings.TrimPrefix(p, m.path), "/")
+		return isPackageDir(filepath.Join(m.dir, filepath.FromSlash(rel)))
+	}
+
+	if _, err := build.Import(p, dir, build.FindOnly); err == nil {
+		return true
+	}
+	return findModCacheDir(p) != ""
+}
+
+// isPackageDir reports whether dir has Go files.
+func isPackageDir(dir string) bool {
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	return err == nil && len(files) > 0
+}
+
+// importPathCandidates returns import paths that may be suggested in dir:
+// importable packages, modules in the module cache and packages in the module of dir.
+// Standard packages newer than goVersion are excluded.
+// The result is cached by module and goVersion.
+func importPathCandidates(dir, goVersion string) []string {
+	m := findGoMod(dir)
+	key := " " + goVersion
+	if m != nil {
+		key = m.dir + key
+	}
+
+	candidatesMu.Lock()
+	defer candidatesMu.Unlock()
+	if candidates, ok := candidatesCache[key]; ok {
+		return candidates
+	}
+
+	seen := map[string]bool{}
+	candidates := []string{}
+	add := func(p string) {
+		if !seen[p] {
+			seen[p] = true
+			candidates = append(candidates, p)
+		}
+	}
+
+	// errors are ignored: packages that are found are still useful
+	pkgs, _ := listImportable(false)
+	for _, pkg := range filterByGoVersion(pkgs, goVersion) {
+		if isVisibleImportPath(pkg.path) {
+			add(pkg.path)
+		}
+	}
+	for _, p := range listModCacheModules() {
+		add(p)
+	}
+	if m != nil && m.path != "" {
+		for _, p := range listModulePackages(m) {
+			add(p)
+		}
+	}
+
+	candidatesCache[key] = candidates
+	return candidates
+}
+
+// listModCacheModules returns the paths of modules in the module cache.
+func listModCacheModules() []string {
+	mods := []string{}
+	for _, modCache := range getModCacheDirs() {
+		filepath.Walk(modCache, func(p string, fi os.FileInfo, err error) error {
+			if err != nil || !fi.IsDir() || p == modCache {
+				return nil
+			}
+
+			rel, err := filepath.Rel(modCache, p)
+			if err != nil || rel == "cache" {
+				return filepath.SkipDir
+			}
+
+			// github.com/!sirupsen/logrus@v1.9.0
+			if i := strings.Index(rel, "@"); i >= 0 {
+				mods = append(mods, unescapeModulePath(filepath.ToSlash(rel[:i])))
+				return filepath.SkipDir
+			}
+			return nil
+		})
+	}
+
+	return mods
+}
+
+// listModulePackages returns the import paths of directories that have Go files in the module m,
+// except nested modules.
+func listModulePackages(m *goMod) []string {
+	pkgs := []string{}
+	filepath.Walk(m.dir, func(p string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return nil
+		}
+
+		name := fi.Name()
+		if fi.IsDir() {
+			if p == m.dir {
+				return nil
+			}
+			if name == "testdata" || name == "vendor" || strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_") {
+				return filepath.SkipDir
+			}
+			if _, err := os.Stat(filepath.Join(p, "go.mod")); err == nil {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if filepath.Ext(name) != ".go" || strings.HasSuffix(name, "_test.go") {
+			return nil
+		}
+		rel, err := filepath.Rel(m.dir, filepath.Dir(p))
+		if err != nil {
+			return nil
+		}
+		pkg := m.path
+		if rel != "." {
+			pkg += "/" + filepath.ToSlash(rel)
+		}
+		if len(pkgs) == 0 || pkgs[len(pkgs)-1] != pkg {
+			pkgs = append(pkgs, pkg)
+		}
+		return nil
+	})
+
+	return pkgs
+}
+
+// suggestImportPaths returns the candidates closest to the import path p, closest first.
+// A candidate is close if its edit distance (ignoring case) is small,
+// or if it has the same last element and the distance is moderate.
+//
+//	github.com/Sirupsen/logrus -> github.com/sirupsen/logrus
+func suggestImportPaths(p string, candidates []string) []string {
+	type suggestion struct {
+		path     string
+		dist     int // ignoring case
+		caseDist int
+	}
+
+	lp := strings.ToLower(p)
+	suggestions := []suggestion{}
+	for _, c := range candidates {
+		if c == p {
+			continue
+		}
+
+		lc := strings.ToLower(c)
+		dist := editDistance(lp, lc)
+		sameName := path.Base(lc) == path.Base(lp) && !majorVersionRe.MatchString(path.Base(lp))
+		if dist > 1+len(p)/10 && !(sameName && dist <= len(p)/2) {
+			continue
+		}
+		suggestions = append(suggestions, suggestion{path: c, dist: dist, caseDist: editDistance(p, c)})
+	}
+
+	sort.Slice(suggestions, func(i, j int) bool {
+		si, sj := suggestions[i], suggestions[j]
+		if si.dist != sj.dist {
+			return si.dist < sj.dist
+		}
+		if si.caseDist != sj.caseDist {
+			return si.caseDist < sj.caseDist
+		}
+		return si.path < sj.path
+	})
+
+	paths := []string{}
+	for i := 0; i < len(suggestions) && i < maxSuggestions; i++ {
+		paths = append(paths, suggestions[i].path)
+	}
+	return paths
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min3(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+
+	return prev[len(rb)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
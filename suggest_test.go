@@ -0,0 +1,59 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{a: "", b: "fmt", expected: 3},
+		{a: "fmt", b: "fmt", expected: 0},
+		{a: "fmtt", b: "fmt", expected: 1},
+		{a: "kitten", b: "sitting", expected: 3},
+	}
+
+	for _, test := range tests {
+		if got := editDistance(test.a, test.b); got != test.expected {
+			t.Errorf("expected %d for %q and %q, but got %d", test.expected, test.a, test.b, got)
+		}
+	}
+}
+
+func TestSuggestImportPaths(t *testing.T) {
+	candidates := []string{
+		"github.com/sirupsen/logrus",
+		"github.com/sirupsen/logrus/hooks/syslog",
+		"github.com/pkg/errors",
+		"errors",
+		"gopkg.in/yaml.v3",
+	}
+
+	tests := []struct {
+		path     string
+		expected []string
+	}{
+		{path: "github.com/Sirupsen/logrus", expected: []string{"github.com/sirupsen/logrus"}},
+		{path: "github.com/sirupsen/logrus/hooks/sislog", expected: []string{"github.com/sirupsen/logrus/hooks/syslog"}},
+		// the same last element
+		{path: "github.com/sirupsn/logrus", expected: []string{"github.com/sirupsen/logrus"}},
+		{path: "github.com/go-errors/errors", expected: []string{"github.com/pkg/errors"}},
+		{path: "gopkg.in/yaml.v2", expected: []string{"gopkg.in/yaml.v3"}},
+		{path: "example.com/unknown", expected: []string{}},
+	}
+
+	for _, test := range tests {
+		if got := suggestImportPaths(test.path, candidates); !reflect.DeepEqual(got, test.expected) {
+			t.Errorf("expected %v for %s, but got %v", test.expected, test.path, got)
+		}
+	}
+}
+
+func TestUnescapeModulePath(t *testing.T) {
+	if got, expected := unescapeModulePath("github.com/!sirupsen/logrus"), "github.com/Sirupsen/logrus"; got != expected {
+		t.Errorf("expected %s, but got %s", expected, got)
+	}
+}
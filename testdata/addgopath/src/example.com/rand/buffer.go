@@ -0,0 +1,6 @@
+package rand
+
+import bs "bytes"
+
+// DefaultBuffer buffers DefaultSource.
+var DefaultBuffer = bs.NewBufferString("")
@@ -0,0 +1,19 @@
+package rand
+
+import "bufio"
+
+// Intn is a fake of math/rand.Intn.
+func Intn(n int) int { return 0 }
+
+// DefaultSeed is the seed of DefaultSource.
+var DefaultSeed = 1
+
+// DefaultSource is the default source.
+var DefaultSource = newSource()
+
+// DefaultReader reads from DefaultSource.
+var DefaultReader = bufio.NewReader(nil)
+
+type source struct{}
+
+func newSource() *source { return &source{} }
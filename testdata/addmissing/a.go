@@ -0,0 +1,5 @@
+package addmissing
+
+import "example.com/rand"
+
+var n = rand.Intn(10)
@@ -0,0 +1,5 @@
+package addmissing
+
+func f() int {
+	return rand.Intn(n)
+}
@@ -0,0 +1,15 @@
+package alias
+
+import (
+	"os"
+
+	v1 "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = v1.Pod{ObjectMeta: meta.ObjectMeta{}}
+
+func f() {
+	stdos := os.Args
+	_ = stdos
+}
@@ -0,0 +1,11 @@
+package a
+
+import (
+	stderrors "errors"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+var _ = corev1.Pod{}
+
+var _ = stderrors.New
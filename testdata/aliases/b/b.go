@@ -0,0 +1,11 @@
+package b
+
+import (
+	"errors"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+var _ = v1.Pod{}
+
+var _ = errors.New
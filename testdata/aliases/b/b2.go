@@ -0,0 +1,10 @@
+package b
+
+import (
+	core "k8s.io/api/core/v1"
+)
+
+func f() {
+	corev1 := core.Pod{}
+	_ = corev1
+}
@@ -0,0 +1,10 @@
+package deprecated
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/Goimps-test/old"
+)
+
+var _ = fmt.Sprint(ioutil.Discard, old.X)
@@ -0,0 +1,10 @@
+package group
+
+import (
+	"example.com/org/b"
+	"k8s.io/api/core/v1"
+	"fmt"
+	"github.com/x/y"
+)
+
+var _ = fmt.X + b.X + y.X + v1.X
@@ -0,0 +1,4 @@
+// Deprecated: use github.com/Goimps-test/bar/v2 instead.
+module github.com/Goimps-test/old
+
+go 1.16
@@ -0,0 +1,4 @@
+package semver
+
+// Version is the version of the module.
+const Version = "v1.11.0-rc.1"
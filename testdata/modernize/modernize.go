@@ -0,0 +1,20 @@
+package modernize
+
+import (
+	"io/ioutil"
+	"os"
+
+	"golang.org/x/exp/slices"
+)
+
+func f(name string) ([]byte, error) {
+	b, err := ioutil.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	if slices.Contains(os.Args, name) {
+		r := ioutil.NopCloser(os.Stdin)
+		return ioutil.ReadAll(r)
+	}
+	return b, ioutil.WriteFile(name, b, 0644)
+}
@@ -0,0 +1,9 @@
+package a
+
+import (
+	"fmt"
+
+	"example.com/m/util"
+)
+
+var _ = fmt.Sprint(util.X())
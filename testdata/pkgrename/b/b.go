@@ -0,0 +1,5 @@
+package b
+
+import u "example.com/m/util"
+
+var _ = u.X()
@@ -0,0 +1,8 @@
+package c
+
+import "example.com/m/util"
+
+func f() int {
+	helpers := util.X()
+	return helpers
+}
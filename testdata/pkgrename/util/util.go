@@ -0,0 +1,3 @@
+package util
+
+func X() int { return 1 }
@@ -0,0 +1,13 @@
+package util_test
+
+import (
+	"testing"
+
+	"example.com/m/util"
+)
+
+func TestX(t *testing.T) {
+	if util.X() != 1 {
+		t.Fail()
+	}
+}
@@ -0,0 +1,11 @@
+package replace
+
+import (
+	"fmt"
+
+	"github.com/Org/old/pkg"
+	"github.com/Org/old/pkg/sub"
+	legacy "github.com/Org/old/pkgutil"
+)
+
+var _ = fmt.Sprint(pkg.X, sub.Y, legacy.Z)
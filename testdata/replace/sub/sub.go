@@ -0,0 +1,7 @@
+package sub
+
+import (
+	"fmt"
+)
+
+var _ = fmt.Sprint
@@ -0,0 +1,7 @@
+package resolve
+
+var (
+	_ = rand.Int
+	_ = template.New
+	_ = errors.New
+)
@@ -0,0 +1,10 @@
+package unresolved
+
+import (
+	"fmtt"
+
+	"github.com/Goimps-test/Bar/v2"
+	yaml "gopkg.in/yaml.v2"
+)
+
+var _ = fmtt.Sprint(bar.X, yaml.Y)
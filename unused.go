@@ -1,6 +1,7 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"go/ast"
 	"go/build"
@@ -9,16 +10,69 @@ import (
 	"io"
 	"io/ioutil"
 	"os"
-	"path"
 	"sync"
 )
 
+const (
+	resolvedByAlias    = "alias"
+	resolvedByImport   = "build.Import"
+	resolvedByModCache = "module cache"
+	resolvedByGuess    = "guess"
+)
+
 type imp struct {
 	name string
 	path string
+
+	// how name was resolved (resolvedBy*) and the directory of the package if it was found.
+	// guessed is true if name was inferred from path only.
+	resolvedBy string
+	dir        string
+	guessed    bool
+
+	// duplicate is true if the path is also imported by an earlier import in the file.
+	duplicate bool
+
+	// selector expressions that refer to name
+	refs []ref
+}
+
+type ref struct {
+	pos  token.Position
+	expr string
 }
 
-func cmdUnused(stdin io.Reader, stdout, stderr io.Writer, filename string) int {
+// alias returns the name given in the import spec, or "" if there is none.
+func (i imp) alias() string {
+	if i.resolvedBy == resolvedByAlias {
+		return i.name
+	}
+	return ""
+}
+
+// isIgnored reports whether the import can never be reported as unused.
+func (i imp) isIgnored() bool {
+	return i.path == "C" || i.name == "_" || i.name == "."
+}
+
+var (
+	unusedFlag  = flag.NewFlagSet("goimps unused flags", 2)
+	unusedNames = unusedFlag.Bool("names", false, `show "name path" to tell imports of the same path apart`)
+)
+
+// cmdUnused shows import paths of unused imports in the file (standard input if it's not given).
+// A path imported more than once can be ambiguous, so -names shows the name of each import too.
+//
+//	$ goimps unused -names a.go
+//	stderrors errors
+func cmdUnused(stdin io.Reader, stdout, stderr io.Writer, args []string) int {
+	defer func() {
+		*unusedNames = false
+	}()
+
+	unusedFlag.Parse(args)
+	filename := unusedFlag.Arg(0)
+
 	var in io.Reader
 
 	if filename == "" {
@@ -48,69 +102,74 @@ func cmdUnused(stdin io.Reader, stdout, stderr io.Writer, filename string) int {
 	}
 
 	for _, u := range unused {
+		if *unusedNames {
+			fmt.Fprintln(stdout, u.name+" "+u.path)
+			continue
+		}
 		fmt.Fprintln(stdout, u.path)
 	}
 	return 0
 }
 
 func getUnused(filename string, src []byte) ([]imp, error) {
+	imps, err := analyzeImports(filename, src)
+	if err != nil {
+		return nil, err
+	}
+
+	return filterUnused(imps), nil
+}
+
+func filterUnused(imps []imp) []imp {
+	unused := []imp{}
+	for _, i := range imps {
+		if !i.isIgnored() && len(i.refs) == 0 {
+			unused = append(unused, i)
+		}
+	}
+
+	return unused
+}
+
+// analyzeImports resolves package names of all imports in src
+// and collects the selector expressions that refer to each of them.
+func analyzeImports(filename string, src []byte) ([]imp, error) {
 	fset := token.NewFileSet()
 	aFile, err := parser.ParseFile(fset, filename, src, parser.Mode(0))
 	if err != nil {
 		return nil, err
 	}
 
-	unused := []imp{}
+	return analyzeFile(fset, aFile), nil
+}
+
+// analyzeFile is same as analyzeImports, but it takes a parsed file.
+// aFile may be a partial AST that the parser returned with errors.
+func analyzeFile(fset *token.FileSet, aFile *ast.File) []imp {
+	imps := make([]imp, len(aFile.Imports))
 	goroutines := &sync.WaitGroup{}
-	pause := make(chan struct{})
-	done := make(chan struct{})
-	importDeclFound := make(chan imp)
-	for _, i := range aFile.Imports {
+	for n, i := range aFile.Imports {
 		goroutines.Add(1)
-		go func(i *ast.ImportSpec) {
+		go func(n int, i *ast.ImportSpec) {
 			defer goroutines.Done()
 
 			p := unquote(i.Path.Value)
-
+			imps[n] = imp{path: p}
 			if p == "C" {
-				<-pause
 				return
 			}
 
-			var name string
-			if i.Name != nil {
-				name = i.Name.Name
-			} else {
-				if pkg, err := build.Import(p, "", 0); err == nil {
-					name = pkg.Name
-				} else {
-					name = path.Base(p)
-				}
-			}
-			if name == "_" || name == "." {
-				<-pause
-				return
-			}
-
-			<-pause
-			importDeclFound <- imp{name: name, path: p}
-		}(i)
-
+			imps[n].name, imps[n].resolvedBy, imps[n].dir = resolveImportName(i)
+			imps[n].guessed = imps[n].resolvedBy == resolvedByGuess
+		}(n, i)
 	}
+	goroutines.Wait()
 
-	go func() {
-		goroutines.Wait()
-		done <- struct{}{}
-	}()
-	close(pause)
-
-DONE:
-	for {
-		select {
-		case i := <-importDeclFound:
-			unused = append(unused, i)
-		case <-done:
-			break DONE
+	for n := range imps {
+		for _, prev := range imps[:n] {
+			if prev.path == imps[n].path && !prev.isIgnored() && !imps[n].isIgnored() {
+				imps[n].duplicate = true
+			}
 		}
 	}
 
@@ -127,10 +186,9 @@ DONE:
 				break
 			}
 
-			// Remove using imports from unused
-			for i, u := range unused {
-				if u.name == xid.Name {
-					unused = append(unused[:i], unused[i+1:]...)
+			for i, u := range imps {
+				if u.name == xid.Name && !u.isIgnored() {
+					imps[i].refs = append(imps[i].refs, ref{pos: fset.Position(n.Pos()), expr: xid.Name + "." + n.Sel.Name})
 				}
 			}
 		}
@@ -138,5 +196,32 @@ DONE:
 		return true
 	})
 
-	return unused, nil
+	return imps
+}
+
+// resolveImportName returns the name that the import spec binds in the file,
+// how it was resolved and the package directory if it was found.
+func resolveImportName(i *ast.ImportSpec) (name, resolvedBy, dir string) {
+	if i.Name != nil {
+		return i.Name.Name, resolvedByAlias, ""
+	}
+
+	return resolvePackageName(unquote(i.Path.Value))
+}
+
+// resolvePackageName returns the package name of the import path p,
+// how it was resolved and the package directory if it was found.
+func resolvePackageName(p string) (name, resolvedBy, dir string) {
+	if pkg, err := build.Import(p, "", 0); err == nil {
+		return pkg.Name, resolvedByImport, pkg.Dir
+	}
+
+	if dir := findModCacheDir(p); dir != "" {
+		// getPackageNameFromGoFiles is faster, but it doesn't know `//go:build ignore`
+		if pkg, err := build.ImportDir(dir, 0); err == nil {
+			return pkg.Name, resolvedByModCache, dir
+		}
+	}
+
+	return inferPackageName(p), resolvedByGuess, ""
 }
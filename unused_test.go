@@ -46,7 +46,7 @@ func foo() {
 	// Input from file
 	var w bytes.Buffer
 
-	if cmdUnused(nil, &w, os.Stderr, f.Name()) != 0 {
+	if cmdUnused(nil, &w, os.Stderr, []string{f.Name()}) != 0 {
 		panic("error in cmdUnused.")
 	}
 
@@ -72,7 +72,7 @@ func foo() {
 	// Input from stdin
 	w.Reset()
 	r := bytes.NewReader([]byte(code))
-	if cmdUnused(r, &w, os.Stderr, "") != 0 {
+	if cmdUnused(r, &w, os.Stderr, nil) != 0 {
 		panic("error in cmdUnused.")
 	}
 
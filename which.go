@@ -0,0 +1,569 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"go/types"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// symbol is an exported package level identifier.
+type symbol struct {
+	name string
+	kind string // func, type, var or const
+	sig  string // func Fields(s string) []string
+}
+
+// pkgSymbol is a symbol in the symbol index.
+type pkgSymbol struct {
+	path    string
+	pkgname string
+	symbol
+}
+
+// cmdWhich shows import paths of packages that export ident, with the kind and the signature of each symbol.
+// Standard packages and symbols newer than the go directive in go.mod are excluded.
+func cmdWhich(stdout, stderr io.Writer, ident string) int {
+	if ident == "" {
+		fmt.Fprintln(stderr, "usage: goimps which [pkgname.]Ident")
+		return 2
+	}
+
+	// strings.Fields -> strings, Fields
+	pkgname := ""
+	if i := strings.LastIndex(ident, "."); i >= 0 {
+		pkgname, ident = ident[:i], ident[i+1:]
+	}
+
+	pkgs, errs := listImportable(false)
+	for _, err := range errs {
+		fmt.Fprintln(stderr, err.Error())
+	}
+
+	w := bufio.NewWriter(stdout)
+	for _, s := range findSymbols(pkgs, pkgname, ident, targetGoVersion(".")) {
+		w.WriteString(s.path + "\t" + s.kind + "\t" + s.sig + "\n")
+	}
+	w.Flush()
+
+	if len(errs) > 0 {
+		return 1
+	}
+	return 0
+}
+
+// findSymbols returns symbols named ident in pkgs, and in packages named pkgname if it isn't "".
+// Standard packages and symbols newer than goVersion are excluded.
+func findSymbols(pkgs []importable, pkgname, ident, goVersion string) []pkgSymbol {
+	filtered := []importable{}
+	for _, pkg := range filterByGoVersion(pkgs, goVersion) {
+		// index only packages that may be named pkgname
+		if pkgname == "" || path.Base(pkg.path) == pkgname || inferPackageName(pkg.path) == pkgname {
+			filtered = append(filtered, pkg)
+		}
+	}
+
+	syms := []pkgSymbol{}
+	for _, s := range indexSymbols(filtered)[ident] {
+		if pkgname != "" && s.pkgname != pkgname {
+			continue
+		}
+		if !isStdAvailable(s.path, s.name, goVersion) {
+			continue
+		}
+		syms = append(syms, s)
+	}
+
+	return syms
+}
+
+// getSymbolCacheFile returns the file that caches symbols of packages between runs,
+// or "" if there is no cache directory.
+var getSymbolCacheFile = func() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "goimps", "symbols.json")
+}
+
+// symbolCacheEntry is the symbols of the package in a directory.
+type symbolCacheEntry struct {
+	ModTime int64          `json:"modtime"` // the latest modification time of the directory and its Go files
+	Name    string         `json:"name"`
+	Symbols []cachedSymbol `json:"symbols"`
+
+	// Deps are the modification times of the directories of imported packages
+	// that types of vars are resolved from.
+	Deps map[string]int64 `json:"deps,omitempty"`
+}
+
+// isFresh reports whether neither the package in the directory modified at modTime
+// nor its dependencies are modified since e is cached.
+func (e symbolCacheEntry) isFresh(modTime int64) bool {
+	if e.ModTime != modTime {
+		return false
+	}
+	for dir, t := range e.Deps {
+		if dt, err := dirModTime(dir); err != nil || dt != t {
+			return false
+		}
+	}
+	return true
+}
+
+type cachedSymbol struct {
+	Name string `json:"name"`
+	Kind string `json:"kind"`
+	Sig  string `json:"sig"`
+}
+
+// loadSymbolCache reads the symbol cache by directory.
+// A missing or broken cache is empty.
+func loadSymbolCache() map[string]symbolCacheEntry {
+	cache := map[string]symbolCacheEntry{}
+	filename := getSymbolCacheFile()
+	if filename == "" {
+		return cache
+	}
+
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return cache
+	}
+	if err := json.Unmarshal(b, &cache); err != nil {
+		return map[string]symbolCacheEntry{}
+	}
+	return cache
+}
+
+// saveSymbolCache writes the symbol cache. Errors are ignored as the cache is rebuilt if it's lost.
+func saveSymbolCache(cache map[string]symbolCacheEntry) {
+	filename := getSymbolCacheFile()
+	if filename == "" {
+		return
+	}
+
+	b, err := json.Marshal(cache)
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		return
+	}
+
+	// write to a temporary file and rename it, not to leave a partial cache to concurrent runs
+	tmp, err := ioutil.TempFile(filepath.Dir(filename), filepath.Base(filename))
+	if err != nil {
+		return
+	}
+	_, err = tmp.Write(b)
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return
+	}
+	if err := os.Rename(tmp.Name(), filename); err != nil {
+		os.Remove(tmp.Name())
+	}
+}
+
+// dirModTime returns the latest modification time of dir and Go files in it, in nanoseconds.
+// Adding or removing a file changes dir, and editing a file changes the file.
+func dirModTime(dir string) (int64, error) {
+	fi, err := os.Stat(dir)
+	if err != nil {
+		return 0, err
+	}
+	latest := fi.ModTime().UnixNano()
+
+	fis, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return 0, err
+	}
+	for _, fi := range fis {
+		if fi.IsDir() || !strings.HasSuffix(fi.Name(), ".go") {
+			continue
+		}
+		if t := fi.ModTime().UnixNano(); t > latest {
+			latest = t
+		}
+	}
+
+	return latest, nil
+}
+
+// indexSymbols collects exported symbols of pkgs, and returns them by name.
+// Symbols of each name are sorted by import path.
+// Symbols of packages are cached by directory, and they are read again only if the directory,
+// or the directory of a package that types of vars are resolved from, is modified.
+func indexSymbols(pkgs []importable) map[string][]pkgSymbol {
+	index := map[string][]pkgSymbol{}
+	mu := &sync.Mutex{}
+	goroutines := &sync.WaitGroup{}
+
+	cache := loadSymbolCache()
+	changed := false
+
+	for _, pkg := range pkgs {
+		if !isVisibleImportPath(pkg.path) {
+			continue
+		}
+
+		goroutines.Add(1)
+		go func(pkg importable) {
+			defer goroutines.Done()
+
+			modTime, err := dirModTime(pkg.dir)
+			if err != nil {
+				return
+			}
+
+			mu.Lock()
+			entry, ok := cache[pkg.dir]
+			mu.Unlock()
+
+			if !ok || !entry.isFresh(modTime) {
+				name, syms, deps, err := readPackageSymbols(pkg.dir)
+				if err != nil {
+					// not buildable in this env
+					return
+				}
+
+				entry = symbolCacheEntry{ModTime: modTime, Name: name, Symbols: []cachedSymbol{}}
+				for _, s := range syms {
+					entry.Symbols = append(entry.Symbols, cachedSymbol{Name: s.name, Kind: s.kind, Sig: s.sig})
+				}
+				for _, dir := range deps {
+					if t, err := dirModTime(dir); err == nil {
+						if entry.Deps == nil {
+							entry.Deps = map[string]int64{}
+						}
+						entry.Deps[dir] = t
+					}
+				}
+
+				mu.Lock()
+				cache[pkg.dir] = entry
+				changed = true
+				mu.Unlock()
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			for _, s := range entry.Symbols {
+				index[s.Name] = append(index[s.Name], pkgSymbol{path: pkg.path, pkgname: entry.Name, symbol: symbol{name: s.Name, kind: s.Kind, sig: s.Sig}})
+			}
+		}(pkg)
+	}
+	goroutines.Wait()
+
+	if changed {
+		saveSymbolCache(cache)
+	}
+
+	for _, syms := range index {
+		sort.Slice(syms, func(i, j int) bool {
+			return syms[i].path < syms[j].path
+		})
+	}
+
+	return index
+}
+
+// packageSymbols returns the package name and exported package level symbols of the package in dir.
+func packageSymbols(dir string) (string, []symbol, error) {
+	name, syms, _, err := readPackageSymbols(dir)
+	return name, syms, err
+}
+
+// readPackageSymbols is packageSymbols, but it also returns the directories of imported packages
+// that types of vars are resolved from.
+func readPackageSymbols(dir string) (string, []symbol, []string, error) {
+	pkg, err := build.ImportDir(dir, 0)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	fset := token.NewFileSet()
+	files := []*ast.File{}
+	for _, name := range pkg.GoFiles {
+		f, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.Mode(0))
+		if err != nil {
+			return "", nil, nil, err
+		}
+		files = append(files, f)
+	}
+
+	funcs := map[string]*ast.FuncDecl{}
+	for _, f := range files {
+		for _, decl := range f.Decls {
+			if decl, ok := decl.(*ast.FuncDecl); ok && decl.Recv == nil {
+				funcs[decl.Name.Name] = decl
+			}
+		}
+	}
+
+	syms := []symbol{}
+	deps := map[string]bool{}
+	for _, f := range files {
+		r := &initTypeResolver{fset: fset, f: f, funcs: funcs, deps: deps}
+		for _, decl := range f.Decls {
+			syms = append(syms, declSymbols(fset, decl, r)...)
+		}
+	}
+
+	depDirs := []string{}
+	for dir := range deps {
+		depDirs = append(depDirs, dir)
+	}
+	sort.Strings(depDirs)
+
+	return pkg.Name, syms, depDirs, nil
+}
+
+// declSymbols returns the exported symbols that decl declares.
+// Types of vars without a type are resolved from their initializers by r.
+func declSymbols(fset *token.FileSet, decl ast.Decl, r *initTypeResolver) []symbol {
+	syms := []symbol{}
+
+	switch decl := decl.(type) {
+	case *ast.FuncDecl:
+		if decl.Recv != nil || !decl.Name.IsExported() {
+			break
+		}
+		sig := nodeString(fset, &ast.FuncDecl{Name: decl.Name, Type: decl.Type})
+		syms = append(syms, symbol{name: decl.Name.Name, kind: "func", sig: sig})
+	case *ast.GenDecl:
+		for _, spec := range decl.Specs {
+			switch spec := spec.(type) {
+			case *ast.TypeSpec:
+				if !spec.Name.IsExported() {
+					continue
+				}
+				// type Builder struct{ ... } -> type Builder struct
+				s := *spec
+				switch spec.Type.(type) {
+				case *ast.StructType:
+					s.Type = ast.NewIdent("struct")
+				case *ast.InterfaceType:
+					s.Type = ast.NewIdent("interface")
+				}
+				syms = append(syms, symbol{name: spec.Name.Name, kind: "type", sig: "type " + nodeString(fset, &s)})
+			case *ast.ValueSpec:
+				kind := decl.Tok.String()
+				for n, name := range spec.Names {
+					if !name.IsExported() {
+						continue
+					}
+					sig := kind + " " + name.Name
+					if spec.Type != nil {
+						sig += " " + nodeString(fset, spec.Type)
+					} else if decl.Tok == token.VAR && len(spec.Values) == len(spec.Names) {
+						// var EOF = errors.New("EOF") -> var EOF error
+						if typ := r.initType(spec.Values[n]); typ != "" {
+							sig += " " + typ
+						}
+					}
+					syms = append(syms, symbol{name: name.Name, kind: kind, sig: sig})
+				}
+			}
+		}
+	}
+
+	return syms
+}
+
+// initTypeResolver resolves types of initializers of vars in the file f,
+// as far as they can be known without type checking.
+type initTypeResolver struct {
+	fset  *token.FileSet
+	f     *ast.File
+	funcs map[string]*ast.FuncDecl // package level funcs of the package of f
+	deps  map[string]bool          // directories of imported packages that types are resolved from, if not nil
+}
+
+// basicLitTypes are the default types of untyped constants.
+var basicLitTypes = map[token.Token]string{
+	token.INT:    "int",
+	token.FLOAT:  "float64",
+	token.IMAG:   "complex128",
+	token.CHAR:   "rune",
+	token.STRING: "string",
+}
+
+// initType returns the type of x, or "" if it can't be known.
+//
+//	"a"                  -> string
+//	&Reader{}            -> *Reader
+//	newReader()          -> the result type of newReader in the package
+//	errors.New("EOF")    -> the result type of New in the imported package, qualified by its name
+//
+// An imported package is qualified by its package name even if it's imported with another name.
+func (r *initTypeResolver) initType(x ast.Expr) string {
+	switch x := x.(type) {
+	case *ast.BasicLit:
+		return basicLitTypes[x.Kind]
+	case *ast.CompositeLit:
+		if x.Type != nil {
+			return nodeString(r.fset, x.Type)
+		}
+	case *ast.UnaryExpr:
+		if lit, ok := x.X.(*ast.CompositeLit); ok && x.Op == token.AND && lit.Type != nil {
+			return "*" + nodeString(r.fset, lit.Type)
+		}
+	case *ast.FuncLit:
+		return nodeString(r.fset, x.Type)
+	case *ast.CallExpr:
+		switch fun := x.Fun.(type) {
+		case *ast.Ident:
+			if decl, ok := r.funcs[fun.Name]; ok {
+				if typ := resultType(decl); typ != nil {
+					return nodeString(r.fset, typ)
+				}
+			}
+		case *ast.SelectorExpr:
+			if id, ok := fun.X.(*ast.Ident); ok && id.Obj == nil {
+				if dir, pkgname := r.importDir(id.Name); dir != "" {
+					if r.deps != nil {
+						r.deps[dir] = true
+					}
+					return importedResultType(dir, pkgname, fun.Sel.Name)
+				}
+			}
+		}
+	}
+
+	return ""
+}
+
+// importDir returns the directory and the package name of the package imported as name in r.f,
+// or "" if it's not found.
+func (r *initTypeResolver) importDir(name string) (string, string) {
+	for _, spec := range r.f.Imports {
+		p := unquote(spec.Path.Value)
+		if spec.Name != nil {
+			if spec.Name.Name != name {
+				continue
+			}
+			pkgname, _, dir := resolvePackageName(p)
+			return dir, pkgname
+		}
+
+		if path.Base(p) != name && inferPackageName(p) != name {
+			continue
+		}
+		if pkgname, _, dir := resolvePackageName(p); pkgname == name {
+			return dir, pkgname
+		}
+	}
+
+	return "", ""
+}
+
+// resultType returns the type of the single result of the func decl, or nil.
+func resultType(decl *ast.FuncDecl) ast.Expr {
+	results := decl.Type.Results
+	if results == nil || len(results.List) != 1 || len(results.List[0].Names) > 1 {
+		return nil
+	}
+	return results.List[0].Type
+}
+
+var (
+	importedResultTypesMu sync.Mutex
+	importedResultTypes   = map[string]map[string]string{} // by directory, then by func name
+)
+
+// importedResultType returns the result type of the exported func name in the package in dir,
+// qualified by pkgname, or "" if it can't be known.
+func importedResultType(dir, pkgname, name string) string {
+	importedResultTypesMu.Lock()
+	defer importedResultTypesMu.Unlock()
+
+	results, ok := importedResultTypes[dir]
+	if !ok {
+		results = map[string]string{}
+		importedResultTypes[dir] = results
+
+		if pkg, err := build.ImportDir(dir, 0); err == nil {
+			fset := token.NewFileSet()
+			for _, filename := range pkg.GoFiles {
+				f, err := parser.ParseFile(fset, filepath.Join(dir, filename), nil, parser.Mode(0))
+				if err != nil {
+					continue
+				}
+				for _, decl := range f.Decls {
+					decl, ok := decl.(*ast.FuncDecl)
+					if !ok || decl.Recv != nil || !decl.Name.IsExported() {
+						continue
+					}
+					if typ := qualifyType(resultType(decl), pkgname); typ != nil {
+						results[decl.Name.Name] = nodeString(fset, typ)
+					}
+				}
+			}
+		}
+	}
+
+	return results[name]
+}
+
+// qualifyType qualifies exported type names in typ by pkgname,
+// or returns nil for unexported types and types that it doesn't handle.
+//
+//	*Reader -> *bufio.Reader
+func qualifyType(typ ast.Expr, pkgname string) ast.Expr {
+	switch typ := typ.(type) {
+	case *ast.Ident:
+		if typ.IsExported() {
+			return &ast.SelectorExpr{X: ast.NewIdent(pkgname), Sel: ast.NewIdent(typ.Name)}
+		}
+		if types.Universe.Lookup(typ.Name) != nil {
+			return typ
+		}
+	case *ast.SelectorExpr:
+		return typ
+	case *ast.StarExpr:
+		if x := qualifyType(typ.X, pkgname); x != nil {
+			return &ast.StarExpr{X: x}
+		}
+	case *ast.ArrayType:
+		if elt := qualifyType(typ.Elt, pkgname); elt != nil {
+			return &ast.ArrayType{Len: typ.Len, Elt: elt}
+		}
+	case *ast.MapType:
+		key, value := qualifyType(typ.Key, pkgname), qualifyType(typ.Value, pkgname)
+		if key != nil && value != nil {
+			return &ast.MapType{Key: key, Value: value}
+		}
+	case *ast.ChanType:
+		if value := qualifyType(typ.Value, pkgname); value != nil {
+			return &ast.ChanType{Dir: typ.Dir, Value: value}
+		}
+	}
+
+	return nil
+}
+
+func nodeString(fset *token.FileSet, node interface{}) string {
+	var buf bytes.Buffer
+	printer.Fprint(&buf, fset, node)
+	return buf.String()
+}
@@ -0,0 +1,217 @@
+package main
+
+import (
+	"bytes"
+	"go/build"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCmdWhich(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "goimps-which-test")
+	if err != nil {
+		panic(err)
+	}
+	defer os.RemoveAll(tmp)
+
+	defer useTestImportPaths("addgopath")()
+
+	orig := getSymbolCacheFile
+	defer func() {
+		getSymbolCacheFile = orig
+	}()
+	getSymbolCacheFile = func() string {
+		return filepath.Join(tmp, "symbols.json")
+	}
+
+	tests := []struct {
+		ident    string
+		expected string
+	}{
+		{
+			ident:    "strings.Fields",
+			expected: "strings\tfunc\tfunc Fields(s string) []string\n",
+		},
+		{
+			ident:    "Fields",
+			expected: "bytes\tfunc\tfunc Fields(s []byte) [][]byte\nstrings\tfunc\tfunc Fields(s string) []string\n",
+		},
+		{
+			ident:    "rand.Intn",
+			expected: "example.com/rand\tfunc\tfunc Intn(n int) int\nmath/rand\tfunc\tfunc Intn(n int) int\n",
+		},
+		{
+			ident:    "strings.Builder",
+			expected: "strings\ttype\ttype Builder struct\n",
+		},
+		{
+			ident:    "io.EOF",
+			expected: "io\tvar\tvar EOF error\n",
+		},
+		{
+			ident:    "http.DefaultClient",
+			expected: "net/http\tvar\tvar DefaultClient *Client\n",
+		},
+		{
+			ident:    "rand.DefaultSeed",
+			expected: "example.com/rand\tvar\tvar DefaultSeed int\n",
+		},
+		{
+			ident:    "rand.DefaultSource",
+			expected: "example.com/rand\tvar\tvar DefaultSource *source\n",
+		},
+		{
+			ident:    "rand.DefaultReader",
+			expected: "example.com/rand\tvar\tvar DefaultReader *bufio.Reader\n",
+		},
+		{
+			ident:    "rand.DefaultBuffer",
+			expected: "example.com/rand\tvar\tvar DefaultBuffer *bytes.Buffer\n",
+		},
+		{
+			ident:    "http.DefaultMaxHeaderBytes",
+			expected: "net/http\tconst\tconst DefaultMaxHeaderBytes\n",
+		},
+	}
+
+	for _, test := range tests {
+		var w bytes.Buffer
+		if cmdWhich(&w, os.Stderr, test.ident) != 0 {
+			t.Errorf("error in cmdWhich %s", test.ident)
+		}
+
+		if got := w.String(); got != test.expected {
+			t.Errorf("expected `goimps which %s` is\n`%s`\nbut got\n`%s`", test.ident, test.expected, got)
+		}
+	}
+}
+
+func TestIndexSymbolsCache(t *testing.T) {
+	tmp, cleanup := writeTestTree(t, map[string]string{
+		"src/example.com/a/a.go": "package a\n\nfunc F() {}\n",
+	})
+	defer cleanup()
+
+	orig := getSymbolCacheFile
+	defer func() {
+		getSymbolCacheFile = orig
+	}()
+	getSymbolCacheFile = func() string {
+		return filepath.Join(tmp, "cache", "symbols.json")
+	}
+
+	dir := filepath.Join(tmp, "src", "example.com", "a")
+	filename := filepath.Join(dir, "a.go")
+	pkgs := []importable{{path: "example.com/a", dir: dir}}
+
+	if syms := indexSymbols(pkgs)["F"]; len(syms) != 1 || syms[0].sig != "func F()" {
+		t.Errorf("unexpected symbols of F: %v", syms)
+	}
+
+	// symbols of unmodified packages are read from the cache
+	b, err := ioutil.ReadFile(getSymbolCacheFile())
+	if err != nil {
+		t.Fatalf("the symbol cache should be written: %s", err)
+	}
+	if err := ioutil.WriteFile(getSymbolCacheFile(), []byte(strings.Replace(string(b), "func F()", "func F() int", 1)), 0644); err != nil {
+		panic(err)
+	}
+	if syms := indexSymbols(pkgs)["F"]; len(syms) != 1 || syms[0].sig != "func F() int" {
+		t.Errorf("symbols of F should be read from the cache: %v", syms)
+	}
+
+	// modified packages are read again
+	if err := ioutil.WriteFile(filename, []byte("package a\n\nfunc F(s string) {}\n"), 0644); err != nil {
+		panic(err)
+	}
+	later := time.Now().Add(time.Minute)
+	if err := os.Chtimes(filename, later, later); err != nil {
+		panic(err)
+	}
+	if syms := indexSymbols(pkgs)["F"]; len(syms) != 1 || syms[0].sig != "func F(s string)" {
+		t.Errorf("symbols of F should be read again from the modified file: %v", syms)
+	}
+}
+
+func TestIndexSymbolsCacheDeps(t *testing.T) {
+	tmp, cleanup := writeTestTree(t, map[string]string{
+		"src/example.com/a/a.go": "package a\n\nimport \"example.com/b\"\n\nvar V = b.New()\n",
+		"src/example.com/b/b.go": "package b\n\ntype T struct{}\n\nfunc New() *T { return nil }\n",
+	})
+	defer cleanup()
+
+	origGopath, origCacheFile := build.Default.GOPATH, getSymbolCacheFile
+	defer func() {
+		build.Default.GOPATH, getSymbolCacheFile = origGopath, origCacheFile
+	}()
+	build.Default.GOPATH = tmp
+	getSymbolCacheFile = func() string {
+		return filepath.Join(tmp, "cache", "symbols.json")
+	}
+	pkgs := []importable{{path: "example.com/a", dir: filepath.Join(tmp, "src", "example.com", "a")}}
+
+	if syms := indexSymbols(pkgs)["V"]; len(syms) != 1 || syms[0].sig != "var V *b.T" {
+		t.Errorf("unexpected symbols of V: %v", syms)
+	}
+
+	// types of vars are resolved again when the imported package is modified
+	filename := filepath.Join(tmp, "src", "example.com", "b", "b.go")
+	if err := ioutil.WriteFile(filename, []byte("package b\n\ntype U struct{}\n\nfunc New() *U { return nil }\n"), 0644); err != nil {
+		panic(err)
+	}
+	later := time.Now().Add(time.Minute)
+	if err := os.Chtimes(filename, later, later); err != nil {
+		panic(err)
+	}
+	importedResultTypesMu.Lock()
+	importedResultTypes = map[string]map[string]string{}
+	importedResultTypesMu.Unlock()
+	if syms := indexSymbols(pkgs)["V"]; len(syms) != 1 || syms[0].sig != "var V *b.U" {
+		t.Errorf("the type of V should be resolved again from the modified package: %v", syms)
+	}
+}
+
+func TestFindSymbolsGoVersion(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "goimps-which-test")
+	if err != nil {
+		panic(err)
+	}
+	defer os.RemoveAll(tmp)
+
+	orig := getSymbolCacheFile
+	defer func() {
+		getSymbolCacheFile = orig
+	}()
+	getSymbolCacheFile = func() string {
+		return filepath.Join(tmp, "symbols.json")
+	}
+
+	src := filepath.Join(build.Default.GOROOT, "src")
+	pkgs := []importable{{path: "slices", dir: filepath.Join(src, "slices")}, {path: "strings", dir: filepath.Join(src, "strings")}}
+
+	tests := []struct {
+		pkgname   string
+		ident     string
+		goVersion string
+		expected  []string
+	}{
+		{ident: "Contains", goVersion: "1.20", expected: []string{"strings"}},
+		{ident: "Contains", goVersion: "1.21", expected: []string{"slices", "strings"}},
+		{pkgname: "strings", ident: "CutPrefix", goVersion: "1.19", expected: []string{}},
+		{pkgname: "strings", ident: "CutPrefix", goVersion: "1.20", expected: []string{"strings"}},
+	}
+
+	for _, test := range tests {
+		got := []string{}
+		for _, s := range findSymbols(pkgs, test.pkgname, test.ident, test.goVersion) {
+			got = append(got, s.path)
+		}
+		if strings.Join(got, " ") != strings.Join(test.expected, " ") {
+			t.Errorf("expected %v for %s.%s in go %s, but got %v", test.expected, test.pkgname, test.ident, test.goVersion, got)
+		}
+	}
+}